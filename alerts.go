@@ -0,0 +1,167 @@
+package sknlinechart
+
+import (
+	"errors"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"image/color"
+	"time"
+)
+
+// AlertCondition side of an alert rule's value that raises the alert
+type AlertCondition int
+
+const (
+	AlertAbove AlertCondition = iota // raised by values above the rule value
+	AlertBelow                       // raised by values below the rule value
+)
+
+// AlertRule raises an alert once a series stays beyond a value for a number
+// of points or a duration, whichever is reached first, clearing when it comes
+// back past the hysteresis margin
+type AlertRule struct {
+	Name        string
+	Series      string
+	Condition   AlertCondition
+	Value       float32
+	Hysteresis  float32       // margin back past Value needed to clear the alert
+	ForPoints   int           // consecutive points beyond Value, zero for no point limit
+	ForDuration time.Duration // time beyond Value by datapoint timestamps, zero for no time limit
+	Color       color.Color   // recolors the points beyond Value while raised, nil leaves them
+	Notify      bool          // sends a desktop notification through fyne.App when raised
+}
+
+// alertState progress of one rule over its series' datapoints
+type alertState struct {
+	rule    AlertRule
+	raised  bool
+	since   time.Time
+	pending []*ChartDatapoint
+}
+
+// beyond reports whether the value is on the raising side of the rule
+func (r AlertRule) beyond(v float32) bool {
+	if r.Condition == AlertBelow {
+		return v < r.Value
+	}
+	return v > r.Value
+}
+
+// cleared reports whether the value is back past the hysteresis margin
+func (r AlertRule) cleared(v float32) bool {
+	if r.Condition == AlertBelow {
+		return v > r.Value+r.Hysteresis
+	}
+	return v < r.Value-r.Hysteresis
+}
+
+// evaluate folds a new datapoint into the rule, true when it raises the alert
+func (s *alertState) evaluate(point *ChartDatapoint, now time.Time) bool {
+	v := (*point).Value()
+	if s.raised {
+		if s.rule.cleared(v) {
+			s.raised = false
+			s.pending = nil
+			return false
+		}
+		if s.rule.beyond(v) {
+			s.pending = append(s.pending[:0], point)
+		}
+		return false
+	}
+	if !s.rule.beyond(v) {
+		s.pending = nil
+		return false
+	}
+	if len(s.pending) == 0 {
+		s.since = now
+	}
+	s.pending = append(s.pending, point)
+	if s.rule.reached(len(s.pending), now.Sub(s.since)) {
+		s.raised = true
+		return true
+	}
+	return false
+}
+
+// reached reports whether either limit set on the rule is met, the first point fires a rule without limits
+func (r AlertRule) reached(points int, elapsed time.Duration) bool {
+	if r.ForPoints <= 0 && r.ForDuration <= 0 {
+		return true
+	}
+	return (r.ForPoints > 0 && points >= r.ForPoints) || (r.ForDuration > 0 && elapsed >= r.ForDuration)
+}
+
+// AddAlertRule evaluates the rule against each datapoint applied to its series
+func (w *LineChartSkn) AddAlertRule(rule AlertRule) error {
+	if rule.Series == "" {
+		return errors.New("alert rule requires a series")
+	}
+	if rule.ForPoints < 0 || rule.ForDuration < 0 || rule.Hysteresis < 0 {
+		return fmt.Errorf("[%s] alert rule points, duration and hysteresis cannot be negative", rule.Name)
+	}
+	w.mapsLock.Lock()
+	w.alertRules = append(w.alertRules, &alertState{rule: rule})
+	w.mapsLock.Unlock()
+	return nil
+}
+
+// ClearAlertRules removes all alert rules and their point colors
+func (w *LineChartSkn) ClearAlertRules() {
+	w.mapsLock.Lock()
+	w.alertRules = nil
+	w.alertColors = map[string]color.Color{}
+	w.seriesLayoutStale = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// SetOnAlertCallback method to call when an alert rule is raised
+func (w *LineChartSkn) SetOnAlertCallback(f func(rule AlertRule, series string, dataPoint ChartDatapoint)) {
+	w.OnAlertCallback = f
+}
+
+// raisedAlert rule raised by a series datapoint
+type raisedAlert struct {
+	rule   AlertRule
+	series string
+	point  ChartDatapoint
+}
+
+// evaluateAlerts runs the series' rules over a new datapoint, caller holds the maps lock
+// returns the rules raised by the point
+func (w *LineChartSkn) evaluateAlerts(series string, point *ChartDatapoint) []raisedAlert {
+	var raised []raisedAlert
+	at := parseTimestamp((*point).Timestamp()) // backfilled points are measured by their own times
+	for _, state := range w.alertRules {
+		if state.rule.Series != series {
+			continue
+		}
+		if state.evaluate(point, at) {
+			raised = append(raised, raisedAlert{rule: state.rule, series: series, point: (*point).Copy()})
+		}
+		if state.raised && state.rule.Color != nil {
+			for _, p := range state.pending {
+				w.alertColors[(*p).ExternalID()] = state.rule.Color
+			}
+		}
+	}
+	return raised
+}
+
+// raiseAlerts fires the callback and notifications for raised rules, without the maps lock
+func (w *LineChartSkn) raiseAlerts(raised []raisedAlert) {
+	for _, alert := range raised {
+		rule, series, point := alert.rule, alert.series, alert.point
+		w.debugLog("LineChartSkn::raiseAlerts() rule: ", rule.Name, ", series: ", series, ", value: ", point.Value())
+		if w.OnAlertCallback != nil {
+			w.OnAlertCallback(rule, series, point)
+		}
+		if rule.Notify && fyne.CurrentApp() != nil {
+			fyne.CurrentApp().SendNotification(fyne.NewNotification(
+				fmt.Sprintf("%s alert: %s", series, rule.Name),
+				fmt.Sprintf("%s is %.2f at %s", series, point.Value(), point.Timestamp()),
+			))
+		}
+	}
+}
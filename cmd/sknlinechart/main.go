@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/app"
@@ -15,7 +16,7 @@ import (
 	"time"
 )
 
-func makeChart(title, footer string) (lc.LineChart, error) {
+func makeChart(title, footer string, smoothing lc.GraphPointSmoothing) (lc.LineChart, error) {
 	dataPoints := map[string][]*lc.ChartDatapoint{} // legend, points
 
 	rand.NewSource(1000.0)
@@ -50,9 +51,27 @@ func makeChart(title, footer string) (lc.LineChart, error) {
 	opts.Add(lc.WithRightScaleLabel("Humidity"))
 	opts.Add(lc.WithDataPoints(dataPoints))
 	opts.Add(lc.WithYScaleFactor(55))
+	opts.Add(lc.WithLegendLastValue(true))
+	opts.Add(lc.WithSeriesSmoothing("SteadyStream", smoothing))
+	opts.Add(lc.WithSeriesStyle("SmoothStream", lc.SeriesStyle{ColorName: theme.ColorYellow, StrokeWidth: 3, MarkerShape: lc.MarkerNone, Interpolation: lc.InterpolateMonotone}))
+	opts.Add(lc.WithSeriesStyle("AllAtOnce", lc.SeriesStyle{DashPattern: []float32{6, 4}, MarkerShape: lc.MarkerSquare}))
+	opts.Add(lc.WithSeriesUnit("Temperature", "°F"))
+	opts.Add(lc.WithSeriesUnit("Humidity", "%"))
+	opts.Add(lc.WithZone(55, 80, theme.SuccessColor(), "Normal"))
+	opts.Add(lc.WithThreshold(90, "High Alarm", theme.ErrorColor(), lc.RightAxis))
 	opts.Add(lc.WithOnHoverPointCallback(func(series string, p lc.ChartDatapoint) {
 		fmt.Printf("Chart Datapoint Selected Callback: series:%s, point: %v\n", series, p)
 	}))
+	opts.Add(lc.WithOnPointTappedCallback(func(series string, p lc.ChartDatapoint) {
+		fmt.Printf("Chart Datapoint Tapped Callback: series:%s, point: %v\n", series, p)
+	}))
+	opts.Add(lc.WithAlertRule(lc.AlertRule{Name: "High Temperature", Series: "Temperature", Value: 90, Hysteresis: 5, ForPoints: 3, Color: theme.ErrorColor()}))
+	opts.Add(lc.WithOnAlertCallback(func(rule lc.AlertRule, series string, p lc.ChartDatapoint) {
+		fmt.Printf("Chart Alert Callback: rule:%s, series:%s, point: %v\n", rule.Name, series, p)
+	}))
+	opts.Add(lc.WithOnRangeSelectedCallback(func(start, end int, pointsBySeries map[string][]lc.ChartDatapoint) {
+		fmt.Printf("Chart Range Selected Callback: start:%d, end:%d, series:%d\n", start, end, len(pointsBySeries))
+	}))
 
 	lineChart, err := lc.NewWithOptions(opts)
 	if err != nil {
@@ -67,11 +86,18 @@ func main() {
 	exitCode := 0
 	windowClosed := false
 	logger := log.New(os.Stdout, "[DEBUG] ", log.Lmicroseconds|log.Lshortfile)
+	smoothingSpec := flag.String("smoothing", "average:window=32", "SteadyStream smoother as kind:name=value,...")
+	flag.Parse()
+
+	smoothing, err := lc.ParseSmoothing(*smoothingSpec, "SmoothStream")
+	if err != nil {
+		logger.Fatalln("smoothing", err.Error())
+	}
 
 	gui := app.NewWithID("net.skoona.sknLineChart")
 	w := gui.NewWindow("Custom Widget Development")
 
-	lineChart, err := makeChart("Skoona Line Chart", "Example Time Series")
+	lineChart, err := makeChart("Skoona Line Chart", "Example Time Series", smoothing)
 
 	go (func(chart lc.LineChart) {
 		var many []*lc.ChartDatapoint
@@ -92,18 +118,12 @@ func main() {
 		}
 		time.Sleep(time.Second)
 
-		smoothed := lc.NewGraphAverage("SmoothStream", 32)
 		for i := 0; i < 300; i++ {
 			if windowClosed {
 				break
 			}
-			dVal := float64(rand.Float32() * 512.0)
-			smoother := smoothed.AddValue(dVal)
-			point := lc.NewChartDatapoint(float32(smoother), theme.ColorYellow, time.Now().Format(time.RFC1123))
-			chart.ApplyDataPoint("SmoothStream", &point)
-
-			point2 := lc.NewChartDatapoint(float32(dVal), theme.ColorPurple, time.Now().Format(time.RFC1123))
-			chart.ApplyDataPoint("SteadyStream", &point2)
+			point := lc.NewChartDatapoint(rand.Float32()*512.0, theme.ColorPurple, time.Now().Format(time.RFC1123))
+			chart.ApplyDataPoint("SteadyStream", &point)
 			if windowClosed {
 				break
 			}
@@ -0,0 +1,61 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2/theme"
+	"image/color"
+	"strconv"
+	"strings"
+)
+
+// ColorBlindSafePalette Okabe-Ito qualitative palette, assigned in order to series given no color
+var ColorBlindSafePalette = []color.Color{
+	color.NRGBA{R: 0xe6, G: 0x9f, B: 0x00, A: 0xff}, // orange
+	color.NRGBA{R: 0x56, G: 0xb4, B: 0xe9, A: 0xff}, // sky blue
+	color.NRGBA{R: 0x00, G: 0x9e, B: 0x73, A: 0xff}, // bluish green
+	color.NRGBA{R: 0xf0, G: 0xe4, B: 0x42, A: 0xff}, // yellow
+	color.NRGBA{R: 0x00, G: 0x72, B: 0xb2, A: 0xff}, // blue
+	color.NRGBA{R: 0xd5, G: 0x5e, B: 0x00, A: 0xff}, // vermillion
+	color.NRGBA{R: 0xcc, G: 0x79, B: 0xa7, A: 0xff}, // reddish purple
+	color.NRGBA{R: 0x99, G: 0x99, B: 0x99, A: 0xff}, // grey
+}
+
+// ParseHexColor converts #rgb, #rrggbb, or #rrggbbaa into a color
+func ParseHexColor(hex string) (color.Color, error) {
+	digits := strings.TrimPrefix(hex, "#")
+	if len(digits) == 3 {
+		digits = string([]byte{digits[0], digits[0], digits[1], digits[1], digits[2], digits[2]})
+	}
+	if len(digits) == 6 {
+		digits += "ff"
+	}
+	if !strings.HasPrefix(hex, "#") || len(digits) != 8 {
+		return nil, fmt.Errorf("hex color %q must look like #rgb, #rrggbb or #rrggbbaa", hex)
+	}
+	v, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return nil, fmt.Errorf("hex color %q: %w", hex, err)
+	}
+	return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, nil
+}
+
+// colorFromName resolves a fyne primary color name or a hex string, false when neither
+func colorFromName(name string) (color.Color, bool) {
+	if strings.HasPrefix(name, "#") {
+		c, err := ParseHexColor(name)
+		return c, err == nil
+	}
+	for _, primary := range theme.PrimaryColorNames() {
+		if name == primary {
+			return theme.PrimaryColorNamed(name), true
+		}
+	}
+	return nil, false
+}
+
+// withAlpha returns the color with its alpha channel replaced
+func withAlpha(c color.Color, alpha uint8) color.Color {
+	nc := color.NRGBAModel.Convert(c).(color.NRGBA)
+	nc.A = alpha
+	return nc
+}
@@ -0,0 +1,41 @@
+package sknlinechart_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"image/color"
+)
+
+var _ = Describe("Color utilities", func() {
+
+	It("should parse hex color strings", func() {
+		c, err := sknlinechart.ParseHexColor("#ff8800")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c).To(Equal(color.NRGBA{R: 0xff, G: 0x88, B: 0x00, A: 0xff}))
+
+		c, err = sknlinechart.ParseHexColor("#f80")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c).To(Equal(color.NRGBA{R: 0xff, G: 0x88, B: 0x00, A: 0xff}))
+
+		c, err = sknlinechart.ParseHexColor("#ff880080")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c).To(Equal(color.NRGBA{R: 0xff, G: 0x88, B: 0x00, A: 0x80}))
+	})
+
+	It("should reject malformed hex color strings", func() {
+		for _, bad := range []string{"ff8800", "#ff88", "#gg8800", ""} {
+			_, err := sknlinechart.ParseHexColor(bad)
+			Expect(err).To(HaveOccurred(), bad)
+		}
+	})
+
+	It("should offer a color blind safe palette of distinct colors", func() {
+		Expect(sknlinechart.ColorBlindSafePalette).To(HaveLen(8))
+		seen := map[color.Color]bool{}
+		for _, c := range sknlinechart.ColorBlindSafePalette {
+			seen[c] = true
+		}
+		Expect(seen).To(HaveLen(8))
+	})
+})
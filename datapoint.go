@@ -3,14 +3,25 @@ package sknlinechart
 import (
 	"fyne.io/fyne/v2"
 	"github.com/google/uuid"
+	"image/color"
 	"strings"
 )
 
 type chartDatapoint struct {
 	value                float32
 	colorName            string
+	color                color.Color
 	timestamp            string
 	externalID           string
+	metadata             map[string]string
+	band                 bool
+	ohlc                 bool
+	uncertain            bool
+	minus                float32
+	plus                 float32
+	open                 float32
+	low                  float32
+	high                 float32
 	markerTopPosition    *fyne.Position
 	markerBottomPosition *fyne.Position
 }
@@ -25,12 +36,65 @@ func NewChartDatapoint(value float32, colorName, timestamp string) ChartDatapoin
 		externalID:           uuid.New().String(),
 	}
 }
+
+// NewChartDatapointWithColor datapoint drawn with any color rather than a theme color name
+func NewChartDatapointWithColor(value float32, c color.Color, timestamp string) ChartDatapoint {
+	return &chartDatapoint{
+		value:                value,
+		color:                c,
+		timestamp:            timestamp,
+		markerTopPosition:    &fyne.Position{X: 0, Y: 0},
+		markerBottomPosition: &fyne.Position{X: 0, Y: 0},
+		externalID:           uuid.New().String(),
+	}
+}
+
+// NewChartDatapointBand datapoint with low and high bounds around its value, for band series
+func NewChartDatapointBand(low, value, high float32, colorName, timestamp string) ChartDatapoint {
+	return &chartDatapoint{
+		value:                value,
+		band:                 true,
+		low:                  low,
+		high:                 high,
+		colorName:            colorName,
+		timestamp:            timestamp,
+		markerTopPosition:    &fyne.Position{X: 0, Y: 0},
+		markerBottomPosition: &fyne.Position{X: 0, Y: 0},
+		externalID:           uuid.New().String(),
+	}
+}
+
+// NewChartDatapointOHLC candlestick datapoint, its value is the close and its band spans low to high
+func NewChartDatapointOHLC(open, high, low, close float32, timestamp string) ChartDatapoint {
+	return &chartDatapoint{
+		value:                close,
+		band:                 true,
+		ohlc:                 true,
+		open:                 open,
+		low:                  low,
+		high:                 high,
+		timestamp:            timestamp,
+		markerTopPosition:    &fyne.Position{X: 0, Y: 0},
+		markerBottomPosition: &fyne.Position{X: 0, Y: 0},
+		externalID:           uuid.New().String(),
+	}
+}
 func (d *chartDatapoint) Copy() ChartDatapoint {
 	return &chartDatapoint{
 		value:                d.value,
+		band:                 d.band,
+		ohlc:                 d.ohlc,
+		uncertain:            d.uncertain,
+		minus:                d.minus,
+		plus:                 d.plus,
+		open:                 d.open,
+		low:                  d.low,
+		high:                 d.high,
 		colorName:            strings.Clone(d.colorName),
+		color:                d.color,
 		timestamp:            strings.Clone(d.timestamp),
 		externalID:           strings.Clone(d.externalID),
+		metadata:             copyMetadata(d.metadata),
 		markerTopPosition:    &fyne.Position{X: 0, Y: 0},
 		markerBottomPosition: &fyne.Position{X: 0, Y: 0},
 	}
@@ -60,6 +124,60 @@ func (d *chartDatapoint) SetMarkerPosition(top *fyne.Position, bottom *fyne.Posi
 func (d *chartDatapoint) SetColorName(n string) {
 	d.colorName = n
 }
+func (d *chartDatapoint) Color() color.Color {
+	return d.color
+}
+func (d *chartDatapoint) SetColor(c color.Color) {
+	d.color = c
+}
 func (d *chartDatapoint) SetTimestamp(t string) {
 	d.timestamp = t
 }
+func (d *chartDatapoint) Band() (float32, float32, bool) {
+	return d.low, d.high, d.band
+}
+func (d *chartDatapoint) SetBand(low, high float32) {
+	d.band = true
+	d.low = low
+	d.high = high
+}
+func (d *chartDatapoint) OHLC() (float32, float32, float32, float32, bool) {
+	return d.open, d.high, d.low, d.value, d.ohlc
+}
+func (d *chartDatapoint) Uncertainty() (float32, float32, bool) {
+	return d.minus, d.plus, d.uncertain
+}
+func (d *chartDatapoint) SetUncertainty(minus, plus float32) {
+	d.uncertain = true
+	d.minus = minus
+	d.plus = plus
+}
+func (d *chartDatapoint) Metadata() map[string]string {
+	return d.metadata
+}
+func (d *chartDatapoint) SetMetadata(key, value string) {
+	if d.metadata == nil {
+		d.metadata = map[string]string{}
+	}
+	d.metadata[key] = value
+}
+
+// bandBounds a datapoint's low and high bounds, single value points span only their value
+func bandBounds(point ChartDatapoint) (float32, float32) {
+	if low, high, ok := point.Band(); ok {
+		return low, high
+	}
+	return point.Value(), point.Value()
+}
+
+// copyMetadata clones the metadata map, nil stays nil
+func copyMetadata(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	c := make(map[string]string, len(m))
+	for k, v := range m {
+		c[strings.Clone(k)] = strings.Clone(v)
+	}
+	return c
+}
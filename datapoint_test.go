@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/skoona/sknlinechart"
+	"image/color"
 	"reflect"
 	"time"
 )
@@ -45,6 +46,12 @@ var _ = Describe("Datapoint Operations", func() {
 		point.SetValue(77.12)
 		Expect(point.Value()).To(BeNumerically("==", float32(77.12)))
 
+		By("should be able to add metadata fields")
+		Expect(point.Metadata()).To(BeNil())
+		point.SetMetadata("unit", "°F")
+		Expect(point.Metadata()).To(HaveKeyWithValue("unit", "°F"))
+		Expect(point.Copy().Metadata()).To(Equal(point.Metadata()))
+
 		By("should be able set marker positions")
 		c := fyne.NewPos(12, 12)
 		d := fyne.NewPos(20, 20)
@@ -54,4 +61,53 @@ var _ = Describe("Datapoint Operations", func() {
 		Expect(*b).To(Equal(d))
 	})
 
+	It("should accept any color in place of a theme color name", func() {
+		orange := color.NRGBA{R: 0xff, G: 0x88, A: 0xff}
+		point := sknlinechart.NewChartDatapointWithColor(62.4, orange, time.Now().Format(time.RFC1123))
+		Expect(point.Color()).To(Equal(orange))
+		Expect(point.ColorName()).To(BeEmpty())
+		Expect(point.Copy().Color()).To(Equal(orange))
+
+		point.SetColor(nil)
+		point.SetColorName("#ff8800")
+		Expect(point.Color()).To(BeNil())
+		Expect(point.ColorName()).To(Equal("#ff8800"))
+	})
+
+	It("should carry low and high bounds for band series", func() {
+		point := sknlinechart.NewChartDatapointBand(40.0, 52.5, 61.0, theme.ColorGreen, time.Now().Format(time.RFC1123))
+		low, high, ok := point.Band()
+		Expect(ok).To(BeTrue())
+		Expect(low).To(Equal(float32(40.0)))
+		Expect(high).To(Equal(float32(61.0)))
+		Expect(point.Value()).To(Equal(float32(52.5)))
+
+		low, high, ok = point.Copy().Band()
+		Expect(ok).To(BeTrue())
+		Expect([]float32{low, high}).To(Equal([]float32{40.0, 61.0}))
+
+		single := sknlinechart.NewChartDatapoint(52.5, theme.ColorGreen, time.Now().Format(time.RFC1123))
+		_, _, ok = single.Band()
+		Expect(ok).To(BeFalse())
+		single.SetBand(50.0, 55.0)
+		_, high, ok = single.Band()
+		Expect(ok).To(BeTrue())
+		Expect(high).To(Equal(float32(55.0)))
+	})
+
+	It("should carry symmetric or asymmetric uncertainty", func() {
+		point := sknlinechart.NewChartDatapoint(20.1, theme.ColorGreen, time.Now().Format(time.RFC1123))
+		_, _, ok := point.Uncertainty()
+		Expect(ok).To(BeFalse())
+
+		point.SetUncertainty(0.5, 0.5)
+		minus, plus, ok := point.Uncertainty()
+		Expect(ok).To(BeTrue())
+		Expect(minus).To(Equal(plus))
+
+		point.SetUncertainty(0.2, 0.7)
+		minus, plus, ok = point.Copy().Uncertainty()
+		Expect(ok).To(BeTrue())
+		Expect([]float32{minus, plus}).To(Equal([]float32{0.2, 0.7}))
+	})
 })
@@ -0,0 +1,187 @@
+package sknlinechart
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// DerivedKind calculation a derived series applies to its source series
+type DerivedKind int
+
+const (
+	DerivedDelta    DerivedKind = iota // change from the previous point
+	DerivedRate                        // change per second between point timestamps
+	DerivedIntegral                    // running trapezoid area, in value-seconds
+)
+
+// seriesDeriver produces a derived series' datapoints from datapoints applied to other series
+type seriesDeriver interface {
+	// name of the derived series
+	name() string
+	// inputs series the derived series is computed from
+	inputs() []string
+	// derive folds a datapoint applied to a series, returning the derived datapoint when one results
+	derive(series string, point ChartDatapoint, dataPoints map[string][]*ChartDatapoint) (ChartDatapoint, bool)
+}
+
+// derivedSeries delta, rate or integral of one source series
+type derivedSeries struct {
+	series string
+	source string
+	kind   DerivedKind
+	seen   bool
+	last   float32
+	at     time.Time
+	timed  bool // at was read from the last point's timestamp
+	total  float64
+}
+
+var _ seriesDeriver = (*derivedSeries)(nil)
+
+func (d *derivedSeries) name() string {
+	return d.series
+}
+
+func (d *derivedSeries) inputs() []string {
+	return []string{d.source}
+}
+
+func (d *derivedSeries) derive(series string, point ChartDatapoint, _ map[string][]*ChartDatapoint) (ChartDatapoint, bool) {
+	if series != d.source {
+		return nil, false
+	}
+	v := point.Value()
+	at, timed := lookupTimestamp(point.Timestamp())
+	first := !d.seen
+	last, lastAt, interval := d.last, d.at, timed && d.timed // both times read from timestamps
+	d.seen, d.last, d.at, d.timed = true, v, at, timed
+
+	var derived float32
+	switch d.kind {
+	case DerivedDelta:
+		if first {
+			return nil, false
+		}
+		derived = v - last
+	case DerivedRate:
+		seconds := at.Sub(lastAt).Seconds()
+		if first || !interval || seconds <= 0 {
+			return nil, false
+		}
+		derived = float32(float64(v-last) / seconds)
+	case DerivedIntegral:
+		if !first && interval {
+			d.total += float64(v+last) / 2 * at.Sub(lastAt).Seconds()
+		}
+		derived = float32(d.total)
+	}
+	return NewChartDatapoint(derived, "", point.Timestamp()), true
+}
+
+// timestampLayouts formats tried when reading datapoint timestamps
+var timestampLayouts = []string{time.RFC3339Nano, time.RFC1123, time.RFC1123Z, time.RFC822, time.UnixDate, time.DateTime}
+
+// parseTimestamp reads a datapoint timestamp, unreadable timestamps are taken as now
+func parseTimestamp(ts string) time.Time {
+	at, _ := lookupTimestamp(ts)
+	return at
+}
+
+// lookupTimestamp reads a datapoint timestamp, false with now when it is unreadable
+func lookupTimestamp(ts string) (time.Time, bool) {
+	for _, layout := range timestampLayouts {
+		if at, err := time.Parse(layout, ts); err == nil {
+			return at, true
+		}
+	}
+	return time.Now(), false
+}
+
+// AddDerivedSeries adds a series computed from each datapoint applied to the source series
+func (w *LineChartSkn) AddDerivedSeries(name, source string, kind DerivedKind) error {
+	if name == "" || source == "" {
+		return errors.New("derived series requires a name and a source series")
+	}
+	if name == source {
+		return fmt.Errorf("[%s] derived series cannot be its own source", name)
+	}
+	if kind < DerivedDelta || kind > DerivedIntegral {
+		return fmt.Errorf("[%s] unknown derived series kind %d", name, kind)
+	}
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	return w.addDeriver(&derivedSeries{series: name, source: source, kind: kind})
+}
+
+// RemoveDerivedSeries stops updating the derived or expression series, its datapoints remain
+func (w *LineChartSkn) RemoveDerivedSeries(name string) {
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	for idx, d := range w.derivers {
+		if d.name() == name {
+			w.derivers = append(w.derivers[:idx], w.derivers[idx+1:]...)
+			return
+		}
+	}
+}
+
+// addDeriver registers the deriver, rejecting one computed from itself through
+// other derived series; caller holds the maps lock
+func (w *LineChartSkn) addDeriver(d seriesDeriver) error {
+	for _, existing := range w.derivers {
+		if existing.name() == d.name() {
+			return fmt.Errorf("[%s] derived series already exists", d.name())
+		}
+	}
+	for _, input := range d.inputs() {
+		if input == d.name() || w.dependsOn(input, d.name(), map[string]bool{}) {
+			return fmt.Errorf("[%s] derived series cannot be computed from itself, through %s", d.name(), input)
+		}
+	}
+	w.derivers = append(w.derivers, d)
+	return nil
+}
+
+// dependsOn reports whether the series is derived, directly or through other derived
+// series, from target; caller holds the maps lock
+func (w *LineChartSkn) dependsOn(series, target string, seen map[string]bool) bool {
+	seen[series] = true
+	for _, d := range w.derivers {
+		if d.name() != series {
+			continue
+		}
+		for _, input := range d.inputs() {
+			if input == target || (!seen[input] && w.dependsOn(input, target, seen)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// maxDerivedDepth limits chains of derived series built on derived series
+const maxDerivedDepth = 8
+
+// appendDataPoint adds the point to the series, shifting out the oldest when full, then
+// feeds it to the alert rules and derived series; caller holds the maps lock
+func (w *LineChartSkn) appendDataPoint(seriesName string, newDataPoint *ChartDatapoint, depth int) []raisedAlert {
+	w.paletteColor(seriesName) // series seen first take the earlier palette colors
+	if len(w.dataPoints[seriesName]) <= w.dataPointXLimit {
+		w.dataPoints[seriesName] = append(w.dataPoints[seriesName], newDataPoint)
+	} else {
+		delete(w.alertColors, (*w.dataPoints[seriesName][0]).ExternalID())
+		w.dataPoints[seriesName] = ShiftSlice(newDataPoint, w.dataPoints[seriesName])
+		w.seriesLayoutStale = true
+	}
+	raised := w.evaluateAlerts(seriesName, newDataPoint)
+	if depth >= maxDerivedDepth {
+		return raised
+	}
+	for _, d := range w.derivers {
+		if derived, ok := d.derive(seriesName, *newDataPoint, w.dataPoints); ok {
+			raised = append(raised, w.appendDataPoint(d.name(), &derived, depth+1)...)
+		}
+	}
+	return raised
+}
@@ -0,0 +1,82 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"image/color"
+)
+
+// errorBar whisker with end caps spanning a datapoint's uncertainty
+type errorBar struct {
+	whisker *canvas.Line
+	upper   *canvas.Line
+	lower   *canvas.Line
+}
+
+func newErrorBar(c color.Color) *errorBar {
+	return &errorBar{
+		whisker: canvas.NewLine(c),
+		upper:   canvas.NewLine(c),
+		lower:   canvas.NewLine(c),
+	}
+}
+
+// place draws the whisker at x from top to bottom, caps are capWidth wide
+func (e *errorBar) place(x, top, bottom, capWidth float32, c color.Color) {
+	half := capWidth / 2
+	e.whisker.Position1 = fyne.NewPos(x, top)
+	e.whisker.Position2 = fyne.NewPos(x, bottom)
+	e.upper.Position1 = fyne.NewPos(x-half, top)
+	e.upper.Position2 = fyne.NewPos(x+half, top)
+	e.lower.Position1 = fyne.NewPos(x-half, bottom)
+	e.lower.Position2 = fyne.NewPos(x+half, bottom)
+	for _, line := range e.lines() {
+		line.StrokeColor = c
+		line.StrokeWidth = 1
+		if !line.Visible() {
+			line.Show()
+		}
+	}
+}
+
+func (e *errorBar) hide() {
+	for _, line := range e.lines() {
+		line.Hide()
+	}
+}
+
+func (e *errorBar) lines() []*canvas.Line {
+	return []*canvas.Line{e.whisker, e.upper, e.lower}
+}
+
+// layoutErrorBar places the whisker for a datapoint's uncertainty centered on x, y
+// datapoints without uncertainty have their whisker hidden
+func (r *lineChartRenderer) layoutErrorBar(series string, idx int, point ChartDatapoint, x, y, yScale, capWidth float32, c color.Color, hidden bool) {
+	for len(r.errorBars[series]) <= idx {
+		r.errorBars[series] = append(r.errorBars[series], newErrorBar(c))
+	}
+	bar := r.errorBars[series][idx]
+	minus, plus, ok := point.Uncertainty()
+	if !ok || hidden {
+		bar.hide()
+		return
+	}
+	top := y - plus*yScale
+	bottom := y + minus*yScale
+	if limit := r.yInc; top < limit { // keep within the plot
+		top = limit
+	}
+	if limit := r.yInc * 14; bottom > limit {
+		bottom = limit
+	}
+	bar.place(x, top, bottom, capWidth, c)
+}
+
+// hideErrorBars hides whiskers from the index onward
+func (r *lineChartRenderer) hideErrorBars(series string, from int) {
+	for idx, bar := range r.errorBars[series] {
+		if idx >= from {
+			bar.hide()
+		}
+	}
+}
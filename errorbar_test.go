@@ -0,0 +1,59 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Error bars", func() {
+
+	It("should span each datapoint's uncertainty, clamped to the plot", func() {
+		lc := renderedUI()
+		stamp := "Mon, 02 Jan 2006 15:04:05 MST"
+		uncertain := func(v, minus, plus float32) sknlinechart.ChartDatapoint {
+			point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, stamp)
+			point.SetUncertainty(minus, plus)
+			return point
+		}
+		points := []sknlinechart.ChartDatapoint{
+			uncertain(50, 5, 5),
+			uncertain(40, 2, 8),
+			uncertain(90, 0, 500),
+			uncertain(5, 50, 0),
+			sknlinechart.NewChartDatapoint(30, theme.ColorBlue, stamp),
+		}
+		for idx := range points {
+			lc.ApplyDataPoint("Lab", &points[idx])
+		}
+		y := func(v float32) float32 { return sknlinechart.ValueY(lc, v) }
+
+		top, bottom, shown := sknlinechart.ErrorBarSpan(lc, "Lab", 0)
+		Expect(shown).To(BeTrue())
+		Expect(top).To(BeNumerically("~", y(55), 1))
+		Expect(bottom).To(BeNumerically("~", y(45), 1))
+		top, bottom, _ = sknlinechart.ErrorBarSpan(lc, "Lab", 1)
+		Expect(top).To(BeNumerically("~", y(48), 1))
+		Expect(bottom).To(BeNumerically("~", y(38), 1))
+
+		By("clamping whiskers to the top and bottom of the plot")
+		top, _, _ = sknlinechart.ErrorBarSpan(lc, "Lab", 2)
+		Expect(top).To(Equal(y(1000)))
+		_, bottom, _ = sknlinechart.ErrorBarSpan(lc, "Lab", 3)
+		Expect(bottom).To(Equal(y(0)))
+
+		By("hiding whiskers of datapoints without uncertainty")
+		_, _, shown = sknlinechart.ErrorBarSpan(lc, "Lab", 4)
+		Expect(shown).To(BeFalse())
+
+		By("showing symmetric and asymmetric uncertainty when hovered")
+		Expect(hoverOver(lc, points[0])).To(Equal("Lab, Index: 0, Value: 50 ±5    [" + stamp + "]"))
+		Expect(hoverOver(lc, points[1])).To(Equal("Lab, Index: 1, Value: 40 +8/-2    [" + stamp + "]"))
+
+		By("hiding whiskers with the series")
+		lc.SetSeriesVisible("Lab", false)
+		_, _, shown = sknlinechart.ErrorBarSpan(lc, "Lab", 0)
+		Expect(shown).To(BeFalse())
+	})
+})
@@ -0,0 +1,144 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"image/color"
+	"time"
+)
+
+// chartEvent vertical event marker, or text annotation, anchored to a datapoint
+// so it scrolls with the data and is dropped when the datapoint rolls off
+type chartEvent struct {
+	externalID string
+	label      string
+	color      color.Color
+}
+
+// eventAnchor ExternalID of the series datapoint at an index, a time.Time, or a timestamp string;
+// a time.Time anchors to the first datapoint whose readable timestamp is not before it
+func eventAnchor(series string, points []*ChartDatapoint, at any) (string, error) {
+	switch v := at.(type) {
+	case int:
+		if v < 0 || v >= len(points) {
+			return "", fmt.Errorf("[%s] event index %d out of range, points: %d", series, v, len(points))
+		}
+		return (*points[v]).ExternalID(), nil
+	case time.Time:
+		for _, point := range points {
+			ts, ok := lookupTimestamp((*point).Timestamp())
+			if ok && !ts.Before(v) {
+				return (*point).ExternalID(), nil
+			}
+		}
+		return "", fmt.Errorf("[%s] no datapoint at or after %s", series, v.Format(time.RFC1123))
+	case string:
+		for _, point := range points {
+			if (*point).Timestamp() == v {
+				return (*point).ExternalID(), nil
+			}
+		}
+		return "", fmt.Errorf("[%s] no datapoint with timestamp %s", series, v)
+	default:
+		return "", fmt.Errorf("[%s] event position must be an int index, time.Time or timestamp string, got %T", series, at)
+	}
+}
+
+// anchoredPoint finds the visible datapoint with the external id, caller holds the maps lock
+func (w *LineChartSkn) anchoredPoint(externalID string) (ChartDatapoint, bool, bool) {
+	for series, points := range w.dataPoints {
+		for _, point := range points {
+			if (*point).ExternalID() == externalID {
+				return *point, true, !w.hiddenSeries[series]
+			}
+		}
+	}
+	return nil, false, false
+}
+
+// layoutEvents places event markers and annotations at their datapoints' markers,
+// forgetting those whose datapoint has rolled off
+func (r *lineChartRenderer) layoutEvents() {
+	top := r.yInc
+	bottom := r.yInc * 14.0
+
+	kept := r.widget.eventMarkers[:0]
+	for _, event := range r.widget.eventMarkers {
+		if _, found, _ := r.widget.anchoredPoint(event.externalID); found {
+			kept = append(kept, event)
+		}
+	}
+	r.widget.eventMarkers = kept
+	for len(r.eventLines) < len(kept) {
+		r.eventLines = append(r.eventLines, canvas.NewLine(color.Transparent))
+		r.eventLabels = append(r.eventLabels, newReferenceLabel())
+	}
+	for idx, line := range r.eventLines {
+		label := r.eventLabels[idx]
+		if idx >= len(kept) {
+			line.Hide()
+			label.Hide()
+			continue
+		}
+		point, _, visible := r.widget.anchoredPoint(kept[idx].externalID)
+		if !visible || r.widget.xyPlot != nil {
+			line.Hide()
+			label.Hide()
+			continue
+		}
+		x := markerCenter(point).X
+		line.Position1 = fyne.NewPos(x, top)
+		line.Position2 = fyne.NewPos(x, bottom)
+		line.StrokeColor = kept[idx].color
+		line.StrokeWidth = 1
+		line.Show()
+		label.Text = kept[idx].label
+		label.Color = kept[idx].color
+		label.Move(fyne.NewPos(x+2, top))
+		label.Show()
+	}
+
+	notes := r.widget.annotations[:0]
+	for _, note := range r.widget.annotations {
+		if _, found, _ := r.widget.anchoredPoint(note.externalID); found {
+			notes = append(notes, note)
+		}
+	}
+	r.widget.annotations = notes
+	for len(r.annotationLabels) < len(notes) {
+		r.annotationLabels = append(r.annotationLabels, newReferenceLabel())
+	}
+	for idx, label := range r.annotationLabels {
+		if idx >= len(notes) {
+			label.Hide()
+			continue
+		}
+		point, _, visible := r.widget.anchoredPoint(notes[idx].externalID)
+		if !visible || r.widget.xyPlot != nil {
+			label.Hide()
+			continue
+		}
+		pointTop, _ := point.MarkerPosition()
+		label.Text = notes[idx].label
+		label.Color = notes[idx].color
+		size := fyne.MeasureText(label.Text, label.TextSize, label.TextStyle)
+		label.Move(fyne.NewPos(markerCenter(point).X-size.Width/2, pointTop.Y-size.Height-2))
+		label.Show()
+	}
+}
+
+// markerCenter center of the datapoint's marker
+func markerCenter(point ChartDatapoint) fyne.Position {
+	top, bottom := point.MarkerPosition()
+	return fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)
+}
+
+// eventObjects event marker lines and labels, drawn behind the series
+func (r *lineChartRenderer) eventObjects() []fyne.CanvasObject {
+	var objs []fyne.CanvasObject
+	for idx, line := range r.eventLines {
+		objs = append(objs, line, r.eventLabels[idx])
+	}
+	return objs
+}
@@ -0,0 +1,197 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/test"
+	"image/color"
+	"math"
+)
+
+// internals exported to the external test package only
+
+var (
+	DashSegments     = dashSegments
+	InterpolatePaths = interpolatePaths
+)
+
+// renderer the chart's renderer, created on first use
+func renderer(lc LineChart) *lineChartRenderer {
+	return test.WidgetRenderer(lc.(*LineChartSkn)).(*lineChartRenderer)
+}
+
+// PlotGeometry the plot's x offset and width of each of its 16 columns
+func PlotGeometry(lc LineChart) (float32, float32) {
+	r := renderer(lc)
+	return r.xOffset, r.xInc
+}
+
+// HoverText the text of the hover popup
+func HoverText(lc LineChart) string {
+	w := lc.(*LineChartSkn)
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.mouseDisplayStr
+}
+
+// ColorLegend the container holding the legend entries
+func ColorLegend(lc LineChart) *fyne.Container {
+	return renderer(lc).colorLegend
+}
+
+// LegendFrame the backdrop drawn behind an overlaid legend
+func LegendFrame(lc LineChart) *canvas.Rectangle {
+	return renderer(lc).legendFrame
+}
+
+// LegendEntry the series' color legend entry
+func LegendEntry(lc LineChart, series string) *legendEntry {
+	for _, o := range renderer(lc).colorLegend.Objects {
+		if e, ok := o.(*legendEntry); ok && e.series == series {
+			return e
+		}
+	}
+	return nil
+}
+
+// LegendText the text of the series' color legend entry
+func LegendText(lc LineChart, series string) *canvas.Text {
+	return LegendEntry(lc, series).text
+}
+
+// SeriesSegment the line drawn from the series' datapoint to the next
+func SeriesSegment(lc LineChart, series string, idx int) *canvas.Line {
+	return renderer(lc).dataPoints[series][idx]
+}
+
+// LegendSeries the series of the legend entries, in legend order
+func LegendSeries(lc LineChart) []string {
+	var names []string
+	for _, o := range renderer(lc).colorLegend.Objects {
+		if e, ok := o.(*legendEntry); ok {
+			names = append(names, e.series)
+		}
+	}
+	return names
+}
+
+// SeriesColor the color the series' first datapoint is drawn with
+func SeriesColor(lc LineChart, series string) color.Color {
+	w := lc.(*LineChartSkn)
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	return w.seriesColor(series, *w.dataPoints[series][0])
+}
+
+// StackBase cumulative values the stacked area series is drawn on top of
+func StackBase(lc LineChart, series string) []float32 {
+	w := lc.(*LineChartSkn)
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	return renderer(lc).stackBase(series)
+}
+
+// AreaFillPixel the pixel function of an area fill raster painting the shape
+func AreaFillPixel(upper, lower []fyne.Position, baseline float32, fill color.NRGBA, gradient bool, size fyne.Size) func(x, y, w, h int) color.Color {
+	a := newAreaFill()
+	a.shape.Store(&areaShape{upper: upper, lower: lower, baseline: baseline, fill: fill, gradient: gradient, size: size})
+	return a.pixel
+}
+
+// BarSlot x offset from the datapoint and width of the bar series' bar
+func BarSlot(lc LineChart, series string, xScale float32) (float32, float32) {
+	w := lc.(*LineChartSkn)
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	return renderer(lc).barSlot(series, xScale)
+}
+
+// BarRects the rectangles drawing the bar series
+func BarRects(lc LineChart, series string) []*canvas.Rectangle {
+	return renderer(lc).barRects[series]
+}
+
+// ValueY the y position the renderer draws a value at
+func ValueY(lc LineChart, v float32) float32 {
+	w := lc.(*LineChartSkn)
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	r := renderer(lc)
+	yScale := (r.yInc * 10) / (10.0 * float32(w.chartScaleMultiplier))
+	return float32(math.Trunc(float64(r.yInc*14 - r.clampValue(v)*yScale)))
+}
+
+// Candle the wick and body drawing the candlestick series' datapoint
+func Candle(lc LineChart, series string, idx int) (*canvas.Line, *canvas.Rectangle) {
+	r := renderer(lc)
+	return r.dataPoints[series][idx], r.barRects[series][idx]
+}
+
+// AreaEdges the upper and lower edges the series' area fill paints between, and whether it is shown
+func AreaEdges(lc LineChart, series string) ([]fyne.Position, []fyne.Position, bool) {
+	fill, ok := renderer(lc).areaFills[series]
+	if !ok || fill.shape.Load() == nil {
+		return nil, nil, false
+	}
+	shape := fill.shape.Load()
+	return shape.upper, shape.lower, fill.raster.Visible()
+}
+
+// ErrorBarSpan the top and bottom of the datapoint's whisker, and whether it is shown
+func ErrorBarSpan(lc LineChart, series string, idx int) (float32, float32, bool) {
+	bar := renderer(lc).errorBars[series][idx]
+	return bar.whisker.Position1.Y, bar.whisker.Position2.Y, bar.whisker.Visible()
+}
+
+// ReferenceColors the colors of the chart's thresholds and of its zones
+func ReferenceColors(lc LineChart) ([]color.Color, []color.Color) {
+	w := lc.(*LineChartSkn)
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	var thresholds, zones []color.Color
+	for _, t := range w.thresholds {
+		thresholds = append(thresholds, t.color)
+	}
+	for _, z := range w.zones {
+		zones = append(zones, z.color)
+	}
+	return thresholds, zones
+}
+
+// XYPairs the x values and y series values the plot pairs
+func XYPairs(plot XYPlot, dataPoints map[string][]*ChartDatapoint) ([]float32, []float32) {
+	var xs, ys []float32
+	for _, p := range plot.pairs(dataPoints) {
+		xs = append(xs, p.x)
+		ys = append(ys, (*p.point).Value())
+	}
+	return xs, ys
+}
+
+// LinearRegression least squares slope and intercept of y on x
+func LinearRegression(xs, ys []float32) (float32, float32, bool) {
+	var pairs []xyPair
+	for idx := range xs {
+		point := NewChartDatapoint(ys[idx], "", "")
+		pairs = append(pairs, xyPair{x: xs[idx], point: &point})
+	}
+	return linearRegression(pairs)
+}
+
+// EventLines x positions of the visible event marker lines, and the text of the visible annotations
+func EventLines(lc LineChart) ([]float32, []string) {
+	r := renderer(lc)
+	var xs []float32
+	for _, line := range r.eventLines {
+		if line.Visible() {
+			xs = append(xs, line.Position1.X)
+		}
+	}
+	var notes []string
+	for _, label := range r.annotationLabels {
+		if label.Visible() {
+			notes = append(notes, label.Text)
+		}
+	}
+	return xs, notes
+}
@@ -0,0 +1,350 @@
+package sknlinechart
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// exprNode evaluates part of a series expression over the latest source values
+type exprNode func(values map[string]float64) float64
+
+// exprParser recursive descent parser for series expressions
+//
+//	expr    := term (('+' | '-') term)*
+//	term    := unary (('*' | '/') unary)*
+//	unary   := '-' unary | primary
+//	primary := number | "series name" | name | func '(' expr (',' expr)* ')' | '(' expr ')'
+type exprParser struct {
+	src     []rune
+	pos     int
+	sources []string
+}
+
+// exprFunctions variadic functions available to series expressions
+var exprFunctions = map[string]func(args []float64) float64{
+	"avg": func(args []float64) float64 {
+		var sum float64
+		for _, a := range args {
+			sum += a
+		}
+		return sum / float64(len(args))
+	},
+	"sum": func(args []float64) float64 {
+		var sum float64
+		for _, a := range args {
+			sum += a
+		}
+		return sum
+	},
+	"min": func(args []float64) float64 {
+		m := args[0]
+		for _, a := range args[1:] {
+			m = math.Min(m, a)
+		}
+		return m
+	},
+	"max": func(args []float64) float64 {
+		m := args[0]
+		for _, a := range args[1:] {
+			m = math.Max(m, a)
+		}
+		return m
+	},
+	"abs": func(args []float64) float64 {
+		return math.Abs(args[0])
+	},
+}
+
+// parseExpression compiles the expression, returning it with the series names it reads
+func parseExpression(expression string) (exprNode, []string, error) {
+	p := &exprParser{src: []rune(expression)}
+	node, err := p.expr()
+	if err != nil {
+		return nil, nil, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.src) {
+		return nil, nil, fmt.Errorf("unexpected %q at %d in expression %q", p.src[p.pos], p.pos, expression)
+	}
+	if len(p.sources) == 0 {
+		return nil, nil, fmt.Errorf("expression %q reads no series", expression)
+	}
+	return node, p.sources, nil
+}
+
+func (p *exprParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// accept consumes the rune when it is next
+func (p *exprParser) accept(r rune) bool {
+	p.skipSpace()
+	if p.pos < len(p.src) && p.src[p.pos] == r {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *exprParser) expr() (exprNode, error) {
+	left, err := p.term()
+	for err == nil {
+		var op rune
+		if p.accept('+') {
+			op = '+'
+		} else if p.accept('-') {
+			op = '-'
+		} else {
+			return left, nil
+		}
+		var right exprNode
+		if right, err = p.term(); err != nil {
+			break
+		}
+		l := left
+		if op == '+' {
+			left = func(v map[string]float64) float64 { return l(v) + right(v) }
+		} else {
+			left = func(v map[string]float64) float64 { return l(v) - right(v) }
+		}
+	}
+	return nil, err
+}
+
+func (p *exprParser) term() (exprNode, error) {
+	left, err := p.unary()
+	for err == nil {
+		var op rune
+		if p.accept('*') {
+			op = '*'
+		} else if p.accept('/') {
+			op = '/'
+		} else {
+			return left, nil
+		}
+		var right exprNode
+		if right, err = p.unary(); err != nil {
+			break
+		}
+		l := left
+		if op == '*' {
+			left = func(v map[string]float64) float64 { return l(v) * right(v) }
+		} else {
+			left = func(v map[string]float64) float64 { return l(v) / right(v) }
+		}
+	}
+	return nil, err
+}
+
+func (p *exprParser) unary() (exprNode, error) {
+	if p.accept('-') {
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return func(v map[string]float64) float64 { return -operand(v) }, nil
+	}
+	return p.primary()
+}
+
+func (p *exprParser) primary() (exprNode, error) {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return nil, fmt.Errorf("unexpected end of expression %q", string(p.src))
+	}
+	switch r := p.src[p.pos]; {
+	case r == '(':
+		p.pos++
+		node, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.accept(')') {
+			return nil, fmt.Errorf("missing ) at %d in expression %q", p.pos, string(p.src))
+		}
+		return node, nil
+	case r == '"':
+		end := p.pos + 1
+		for end < len(p.src) && p.src[end] != '"' {
+			end++
+		}
+		if end >= len(p.src) {
+			return nil, fmt.Errorf("unterminated series name at %d in expression %q", p.pos, string(p.src))
+		}
+		name := string(p.src[p.pos+1 : end])
+		p.pos = end + 1
+		return p.series(name), nil
+	case unicode.IsDigit(r) || r == '.':
+		start := p.pos
+		for p.pos < len(p.src) && (unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		n, err := strconv.ParseFloat(string(p.src[start:p.pos]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("bad number %q in expression %q", string(p.src[start:p.pos]), string(p.src))
+		}
+		return func(map[string]float64) float64 { return n }, nil
+	case unicode.IsLetter(r) || r == '_':
+		start := p.pos
+		for p.pos < len(p.src) && (unicode.IsLetter(p.src[p.pos]) || unicode.IsDigit(p.src[p.pos]) || p.src[p.pos] == '_') {
+			p.pos++
+		}
+		name := string(p.src[start:p.pos])
+		if !p.accept('(') {
+			return p.series(name), nil
+		}
+		fn, ok := exprFunctions[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown function %s in expression %q", name, string(p.src))
+		}
+		var args []exprNode
+		for {
+			arg, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.accept(')') {
+				break
+			}
+			if !p.accept(',') {
+				return nil, fmt.Errorf("expected , or ) at %d in expression %q", p.pos, string(p.src))
+			}
+		}
+		return func(v map[string]float64) float64 {
+			vals := make([]float64, len(args))
+			for idx, arg := range args {
+				vals[idx] = arg(v)
+			}
+			return fn(vals)
+		}, nil
+	default:
+		return nil, fmt.Errorf("unexpected %q at %d in expression %q", r, p.pos, string(p.src))
+	}
+}
+
+// series node reading the named series' value, recording it as a source
+func (p *exprParser) series(name string) exprNode {
+	found := false
+	for _, s := range p.sources {
+		found = found || s == name
+	}
+	if !found {
+		p.sources = append(p.sources, name)
+	}
+	return func(v map[string]float64) float64 { return v[name] }
+}
+
+// expressionSeries derived series computed from an expression over other series,
+// pairing their datapoints by index or timestamp
+type expressionSeries struct {
+	series  string
+	eval    exprNode
+	sources []string
+	match   SeriesMatch
+	queued  map[string][]float64          // by source, values not yet paired by index
+	byTime  map[string]map[string]float64 // by timestamp, source values not yet complete
+	times   []string                      // timestamps in byTime, oldest first
+}
+
+var _ seriesDeriver = (*expressionSeries)(nil)
+
+func (e *expressionSeries) name() string {
+	return e.series
+}
+
+func (e *expressionSeries) inputs() []string {
+	return e.sources
+}
+
+func (e *expressionSeries) derive(series string, point ChartDatapoint, _ map[string][]*ChartDatapoint) (ChartDatapoint, bool) {
+	isSource := false
+	for _, s := range e.sources {
+		isSource = isSource || s == series
+	}
+	if !isSource {
+		return nil, false
+	}
+	values := map[string]float64{}
+	if e.match == MatchByTimestamp {
+		ts := point.Timestamp()
+		row, ok := e.byTime[ts]
+		if !ok {
+			row = map[string]float64{}
+			e.byTime[ts] = row
+			e.times = append(e.times, ts)
+			if len(e.times) > maxPendingRows { // sources that never report this timestamp
+				delete(e.byTime, e.times[0])
+				e.times = e.times[1:]
+			}
+		}
+		row[series] = float64(point.Value())
+		if len(row) < len(e.sources) {
+			return nil, false
+		}
+		delete(e.byTime, ts)
+		for idx, t := range e.times {
+			if t == ts {
+				e.times = append(e.times[:idx], e.times[idx+1:]...)
+				break
+			}
+		}
+		values = row
+	} else {
+		if len(e.queued[series]) >= maxPendingRows { // other sources stopped reporting, keep the newest
+			e.queued[series] = e.queued[series][1:]
+		}
+		e.queued[series] = append(e.queued[series], float64(point.Value()))
+		for _, s := range e.sources {
+			if len(e.queued[s]) == 0 {
+				return nil, false
+			}
+		}
+		for _, s := range e.sources {
+			values[s] = e.queued[s][0]
+			e.queued[s] = e.queued[s][1:]
+		}
+	}
+	v := e.eval(values)
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return nil, false
+	}
+	return NewChartDatapoint(float32(v), "", point.Timestamp()), true
+}
+
+// maxPendingRows limits values held waiting for the other sources of an expression
+const maxPendingRows = 150
+
+// AddExpressionSeries adds a series computed from an expression over other series, such as
+// "Temperature" - "Setpoint", avg(A, B, C) or A*9/5+32; quote series names that are not
+// simple words, functions are avg, sum, min, max and abs
+func (w *LineChartSkn) AddExpressionSeries(name, expression string, match SeriesMatch) error {
+	if name == "" {
+		return errors.New("expression series requires a name")
+	}
+	eval, sources, err := parseExpression(expression)
+	if err != nil {
+		return fmt.Errorf("[%s] %w", name, err)
+	}
+	for _, s := range sources {
+		if s == name {
+			return fmt.Errorf("[%s] expression series cannot read itself", name)
+		}
+	}
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	return w.addDeriver(&expressionSeries{
+		series:  name,
+		eval:    eval,
+		sources: sources,
+		match:   match,
+		queued:  map[string][]float64{},
+		byTime:  map[string]map[string]float64{},
+	})
+}
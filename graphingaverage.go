@@ -3,40 +3,62 @@ package sknlinechart
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 )
 
+// GraphAverage rolling average of the last window values
 type GraphAverage struct {
-	seriesName  string
-	graphPeriod time.Duration
-	size        time.Duration
-	dataPoints  []float64
+	mu         sync.Mutex
+	seriesName string
+	window     int
+	current    float64
+	dataPoints []float64
 }
 
 var _ (GraphPointSmoothing) = (*GraphAverage)(nil)
 
-func NewGraphAverage(seriesName string, graphPeriod time.Duration) *GraphAverage {
+// NewGraphAverageWindow averages the last window values, a window below one averages one value
+func NewGraphAverageWindow(seriesName string, window int) *GraphAverage {
+	if window < 1 {
+		window = 1
+	}
 	return &GraphAverage{
-		seriesName:  seriesName,
-		graphPeriod: graphPeriod,
-		size:        1,
-		dataPoints:  []float64{1.0}, // avoids first value being zero
+		seriesName: seriesName,
+		window:     window,
 	}
 }
 
+// NewGraphAverage averages the last graphPeriod values, graphPeriod counts samples
+//
+// Deprecated: graphPeriod is a sample count, not a duration; use NewGraphAverageWindow
+func NewGraphAverage(seriesName string, graphPeriod time.Duration) *GraphAverage {
+	return NewGraphAverageWindow(seriesName, int(graphPeriod))
+}
+
 // AddValue adds the given float64 value into the queue
 // and return the average value of the queue
 // value queue's size is limited by graph period config value
 func (g *GraphAverage) AddValue(value float64) float64 {
-	if g.size >= g.graphPeriod {
-		g.dataPoints = ShiftSlice(value, g.dataPoints)
-	} else {
-		g.dataPoints = append(g.dataPoints, value)
-	}
-
-	g.size = time.Duration(len(g.dataPoints))
-
-	return g.computeAverage()
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.dataPoints = appendWindow(g.dataPoints, value, g.window)
+	g.current = g.computeAverage()
+	return g.current
+}
+func (g *GraphAverage) Current() float64 {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.current
+}
+func (g *GraphAverage) Window() int {
+	return g.window
+}
+func (g *GraphAverage) Reset() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.dataPoints = nil
+	g.current = 0
 }
 func (g *GraphAverage) SeriesName() string {
 	return strings.Clone(g.seriesName)
@@ -46,10 +68,12 @@ func (g *GraphAverage) computeAverage() float64 {
 	for _, fval := range g.dataPoints {
 		sum = sum + fval
 	}
-	return (sum / float64(g.size))
+	return sum / float64(len(g.dataPoints))
 }
 func (g *GraphAverage) String() string {
-	return fmt.Sprint("series:", g.seriesName, ", graphPeriod:", g.graphPeriod, ", dataPoints:", g.dataPoints)
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return fmt.Sprint("series:", g.seriesName, ", window:", g.window, ", count:", len(g.dataPoints), ", current:", g.current)
 }
 func (g *GraphAverage) IsNil() bool {
 	return g == nil
@@ -0,0 +1,114 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ExponentialAverage exponentially weighted moving average, the first value
+// starts the average so there is no warm-up bias toward a seed value
+type ExponentialAverage struct {
+	mu         sync.Mutex
+	seriesName string
+	alpha      float64
+	halfLife   time.Duration
+	value      float64
+	at         time.Time
+	primed     bool
+}
+
+var _ (GraphPointSmoothing) = (*ExponentialAverage)(nil)
+
+// NewExponentialAverage weights each new value by alpha, 0.0 < alpha <= 1.0
+func NewExponentialAverage(seriesName string, alpha float64) (*ExponentialAverage, error) {
+	if !(alpha > 0 && alpha <= 1) {
+		return nil, fmt.Errorf("exponential average alpha must be greater than 0.0 and at most 1.0, got %v", alpha)
+	}
+	return &ExponentialAverage{
+		seriesName: seriesName,
+		alpha:      alpha,
+	}, nil
+}
+
+// NewExponentialAverageHalfLife the weight of a value halves after halfLife more samples
+func NewExponentialAverageHalfLife(seriesName string, halfLife float64) (*ExponentialAverage, error) {
+	if !(halfLife > 0) || math.IsInf(halfLife, 0) {
+		return nil, fmt.Errorf("exponential average half-life must be greater than 0 samples, got %v", halfLife)
+	}
+	return NewExponentialAverage(seriesName, 1.0-math.Pow(0.5, 1.0/halfLife))
+}
+
+// NewTimeExponentialAverage the weight of a value halves after halfLife of time,
+// so irregularly spaced samples are weighted by the time between them
+func NewTimeExponentialAverage(seriesName string, halfLife time.Duration) (*ExponentialAverage, error) {
+	if halfLife <= 0 {
+		return nil, fmt.Errorf("exponential average half-life must be greater than 0, got %s", halfLife)
+	}
+	return &ExponentialAverage{
+		seriesName: seriesName,
+		alpha:      1.0,
+		halfLife:   halfLife,
+	}, nil
+}
+
+// AddValue adds the value and returns the average, time-aware averages
+// take the value as sampled now
+func (e *ExponentialAverage) AddValue(value float64) float64 {
+	return e.AddValueAt(value, time.Now())
+}
+
+// AddValueAt adds a value sampled at the given time and returns the average,
+// the time is only used by time-aware averages
+func (e *ExponentialAverage) AddValueAt(value float64, at time.Time) float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.primed {
+		e.value = value
+		e.at = at
+		e.primed = true
+		return e.value
+	}
+	alpha := e.alpha
+	if e.halfLife > 0 {
+		elapsed := at.Sub(e.at)
+		if elapsed < 0 {
+			elapsed = 0
+		}
+		alpha = 1.0 - math.Pow(0.5, float64(elapsed)/float64(e.halfLife))
+		e.at = at
+	}
+	e.value += alpha * (value - e.value)
+	return e.value
+}
+func (e *ExponentialAverage) Current() float64 {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.value
+}
+func (e *ExponentialAverage) Window() int {
+	return 0
+}
+func (e *ExponentialAverage) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.value = 0
+	e.at = time.Time{}
+	e.primed = false
+}
+func (e *ExponentialAverage) SeriesName() string {
+	return strings.Clone(e.seriesName)
+}
+func (e *ExponentialAverage) String() string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.halfLife > 0 {
+		return fmt.Sprint("series:", e.seriesName, ", halfLife:", e.halfLife, ", value:", e.value)
+	}
+	return fmt.Sprint("series:", e.seriesName, ", alpha:", e.alpha, ", value:", e.value)
+}
+func (e *ExponentialAverage) IsNil() bool {
+	return e == nil
+}
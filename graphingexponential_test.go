@@ -0,0 +1,51 @@
+package sknlinechart_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"math"
+	"time"
+)
+
+var _ = Describe("Exponential average smoothing", func() {
+
+	It("should start from the first value without a seed", func() {
+		ema, err := sknlinechart.NewExponentialAverage("Smooth", 0.5)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ema.AddValue(10)).To(Equal(10.0))
+		Expect(ema.AddValue(20)).To(Equal(15.0))
+		Expect(ema.AddValue(20)).To(Equal(17.5))
+		Expect(ema.SeriesName()).To(Equal("Smooth"))
+	})
+
+	It("should derive alpha from a half-life in samples", func() {
+		ema, err := sknlinechart.NewExponentialAverageHalfLife("Smooth", 1)
+		Expect(err).NotTo(HaveOccurred())
+		ema.AddValue(0)
+		Expect(ema.AddValue(100)).To(BeNumerically("~", 50.0, 1e-9))
+	})
+
+	It("should weight irregular samples by the time between them", func() {
+		ema, err := sknlinechart.NewTimeExponentialAverage("Smooth", time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		ema.AddValueAt(0, start)
+		Expect(ema.AddValueAt(100, start.Add(time.Minute))).To(BeNumerically("~", 50.0, 1e-9))
+		Expect(ema.AddValueAt(100, start.Add(3*time.Minute))).To(BeNumerically("~", 87.5, 1e-9))
+		Expect(ema.AddValueAt(0, start.Add(3*time.Minute))).To(BeNumerically("~", 87.5, 1e-9))
+	})
+
+	It("should reject smoothing factors that never move or never smooth", func() {
+		for _, alpha := range []float64{0, -0.5, 1.5, math.NaN()} {
+			_, err := sknlinechart.NewExponentialAverage("Smooth", alpha)
+			Expect(err).To(HaveOccurred(), "alpha %v", alpha)
+		}
+		_, err := sknlinechart.NewExponentialAverageHalfLife("Smooth", 0)
+		Expect(err).To(HaveOccurred())
+		_, err = sknlinechart.NewTimeExponentialAverage("Smooth", 0)
+		Expect(err).To(HaveOccurred())
+		_, err = sknlinechart.ParseSmoothing("ema:alpha=0", "Smooth")
+		Expect(err).To(HaveOccurred())
+	})
+})
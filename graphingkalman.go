@@ -0,0 +1,90 @@
+package sknlinechart
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+)
+
+// KalmanFilter one dimensional Kalman filter assuming a slowly wandering value,
+// low lag smoothing for noisy readings; the first value starts the estimate
+type KalmanFilter struct {
+	mu               sync.Mutex
+	seriesName       string
+	processNoise     float64
+	measurementNoise float64
+	estimate         float64
+	variance         float64
+	primed           bool
+}
+
+var _ (GraphPointSmoothing) = (*KalmanFilter)(nil)
+
+// NewKalmanFilter processNoise is how much the true value may move between readings,
+// measurementNoise the variance of the readings; a larger ratio of process to
+// measurement noise follows readings more closely; neither may be negative, nor both zero
+func NewKalmanFilter(seriesName string, processNoise, measurementNoise float64) (*KalmanFilter, error) {
+	if !(processNoise >= 0) || !(measurementNoise >= 0) || math.IsInf(processNoise, 0) || math.IsInf(measurementNoise, 0) {
+		return nil, fmt.Errorf("kalman filter noise must be finite and not negative, got process: %v, measurement: %v", processNoise, measurementNoise)
+	}
+	if processNoise == 0 && measurementNoise == 0 {
+		return nil, errors.New("kalman filter process and measurement noise cannot both be zero")
+	}
+	return &KalmanFilter{
+		seriesName:       seriesName,
+		processNoise:     processNoise,
+		measurementNoise: measurementNoise,
+	}, nil
+}
+
+// AddValue folds the reading into the estimate and returns the filtered value
+func (k *KalmanFilter) AddValue(value float64) float64 {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	if !k.primed {
+		k.estimate = value
+		k.variance = k.measurementNoise
+		k.primed = true
+		return k.estimate
+	}
+	k.variance += k.processNoise
+	gain := k.variance / (k.variance + k.measurementNoise)
+	k.estimate += gain * (value - k.estimate)
+	k.variance *= 1 - gain
+	return k.estimate
+}
+
+// Variance of the current estimate
+func (k *KalmanFilter) Variance() float64 {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.variance
+}
+func (k *KalmanFilter) Current() float64 {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.estimate
+}
+func (k *KalmanFilter) Window() int {
+	return 0
+}
+func (k *KalmanFilter) Reset() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.estimate = 0
+	k.variance = 0
+	k.primed = false
+}
+func (k *KalmanFilter) SeriesName() string {
+	return strings.Clone(k.seriesName)
+}
+func (k *KalmanFilter) String() string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return fmt.Sprint("series:", k.seriesName, ", processNoise:", k.processNoise, ", measurementNoise:", k.measurementNoise, ", estimate:", k.estimate, ", variance:", k.variance)
+}
+func (k *KalmanFilter) IsNil() bool {
+	return k == nil
+}
@@ -0,0 +1,40 @@
+package sknlinechart_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"math"
+)
+
+var _ = Describe("Kalman filter smoothing", func() {
+
+	It("should filter readings and narrow the estimate variance", func() {
+		kf, err := sknlinechart.NewKalmanFilter("Accel", 1, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(kf.AddValue(10)).To(Equal(10.0))
+		Expect(kf.Variance()).To(Equal(1.0))
+
+		// variance 1 + 1 process noise gives a gain of 2/3
+		Expect(kf.AddValue(13)).To(BeNumerically("~", 12.0, 1e-9))
+		Expect(kf.Variance()).To(BeNumerically("~", 2.0/3.0, 1e-9))
+	})
+
+	It("should follow readings closely when process noise dominates", func() {
+		kf, err := sknlinechart.NewKalmanFilter("Accel", 100, 0.01)
+		Expect(err).NotTo(HaveOccurred())
+		kf.AddValue(0)
+		Expect(kf.AddValue(50)).To(BeNumerically("~", 50.0, 0.01))
+	})
+
+	It("should reject noise that makes the gain undefined", func() {
+		for _, noise := range [][2]float64{{0, 0}, {-1, 1}, {1, -1}, {math.NaN(), 1}, {math.Inf(1), 1}} {
+			_, err := sknlinechart.NewKalmanFilter("Accel", noise[0], noise[1])
+			Expect(err).To(HaveOccurred(), "noise %v", noise)
+		}
+		kf, err := sknlinechart.NewKalmanFilter("Accel", 0, 1)
+		Expect(err).NotTo(HaveOccurred())
+		kf.AddValue(10)
+		Expect(kf.AddValue(20)).To(Equal(15.0))
+	})
+})
@@ -0,0 +1,161 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RollingMedian median of the last window values, single sample glitches
+// are dropped rather than averaged into the line
+type RollingMedian struct {
+	mu         sync.Mutex
+	seriesName string
+	window     int
+	current    float64
+	dataPoints []float64
+}
+
+var _ (GraphPointSmoothing) = (*RollingMedian)(nil)
+
+func NewRollingMedian(seriesName string, window int) *RollingMedian {
+	if window < 1 {
+		window = 1
+	}
+	return &RollingMedian{
+		seriesName: seriesName,
+		window:     window,
+	}
+}
+
+// AddValue adds the value and returns the median of the window
+func (m *RollingMedian) AddValue(value float64) float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dataPoints = appendWindow(m.dataPoints, value, m.window)
+	m.current = median(m.dataPoints)
+	return m.current
+}
+func (m *RollingMedian) Current() float64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.current
+}
+func (m *RollingMedian) Window() int {
+	return m.window
+}
+func (m *RollingMedian) Reset() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.dataPoints = nil
+	m.current = 0
+}
+func (m *RollingMedian) SeriesName() string {
+	return strings.Clone(m.seriesName)
+}
+func (m *RollingMedian) String() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return fmt.Sprint("series:", m.seriesName, ", window:", m.window, ", count:", len(m.dataPoints), ", current:", m.current)
+}
+func (m *RollingMedian) IsNil() bool {
+	return m == nil
+}
+
+// HampelFilter replaces values further than threshold scaled median absolute
+// deviations from the window's median with that median, passing others unchanged
+type HampelFilter struct {
+	mu         sync.Mutex
+	seriesName string
+	window     int
+	threshold  float64
+	current    float64
+	dataPoints []float64
+}
+
+var _ (GraphPointSmoothing) = (*HampelFilter)(nil)
+
+// madScale makes the median absolute deviation comparable to a standard deviation
+const madScale = 1.4826
+
+// NewHampelFilter threshold is in standard deviations, 3.0 is typical;
+// returns an error unless the threshold is positive and finite
+func NewHampelFilter(seriesName string, window int, threshold float64) (*HampelFilter, error) {
+	if !(threshold > 0) || math.IsInf(threshold, 1) {
+		return nil, fmt.Errorf("[%s] hampel threshold must be positive and finite, got %v", seriesName, threshold)
+	}
+	if window < 1 {
+		window = 1
+	}
+	return &HampelFilter{
+		seriesName: seriesName,
+		window:     window,
+		threshold:  threshold,
+	}, nil
+}
+
+// AddValue adds the value and returns it, or the window's median when it is an outlier
+func (h *HampelFilter) AddValue(value float64) float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.dataPoints = appendWindow(h.dataPoints, value, h.window)
+	mid := median(h.dataPoints)
+	deviations := make([]float64, len(h.dataPoints))
+	for idx, v := range h.dataPoints {
+		deviations[idx] = math.Abs(v - mid)
+	}
+	h.current = value
+	if math.Abs(value-mid) > h.threshold*madScale*median(deviations) {
+		h.current = mid
+	}
+	return h.current
+}
+func (h *HampelFilter) Current() float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.current
+}
+func (h *HampelFilter) Window() int {
+	return h.window
+}
+func (h *HampelFilter) Reset() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.dataPoints = nil
+	h.current = 0
+}
+func (h *HampelFilter) SeriesName() string {
+	return strings.Clone(h.seriesName)
+}
+func (h *HampelFilter) String() string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return fmt.Sprint("series:", h.seriesName, ", window:", h.window, ", threshold:", h.threshold, ", count:", len(h.dataPoints), ", current:", h.current)
+}
+func (h *HampelFilter) IsNil() bool {
+	return h == nil
+}
+
+// appendWindow appends the value, dropping the oldest once the window is full
+func appendWindow(values []float64, value float64, window int) []float64 {
+	if len(values) >= window {
+		return ShiftSlice(value, values)
+	}
+	return append(values, value)
+}
+
+// median middle value, or mean of the middle two, of unsorted values
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
@@ -0,0 +1,39 @@
+package sknlinechart_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"math"
+)
+
+var _ = Describe("Median and outlier rejecting smoothing", func() {
+
+	It("should drop single sample glitches with a rolling median", func() {
+		med := sknlinechart.NewRollingMedian("Humidity", 3)
+		var out []float64
+		for _, v := range []float64{50, 52, 0, 51, 53} {
+			out = append(out, med.AddValue(v))
+		}
+		Expect(out).To(Equal([]float64{50, 51, 50, 51, 51}))
+	})
+
+	It("should replace outliers and pass normal values with a Hampel filter", func() {
+		hampel, err := sknlinechart.NewHampelFilter("Humidity", 5, 3.0)
+		Expect(err).NotTo(HaveOccurred())
+		var out []float64
+		for _, v := range []float64{50, 51, 49, 50, 0, 52, 48} {
+			out = append(out, hampel.AddValue(v))
+		}
+		Expect(out).To(Equal([]float64{50, 51, 49, 50, 50, 52, 48}))
+	})
+
+	It("should reject a Hampel threshold that is not positive", func() {
+		for _, threshold := range []float64{0, -1, math.NaN(), math.Inf(1)} {
+			_, err := sknlinechart.NewHampelFilter("Humidity", 5, threshold)
+			Expect(err).To(HaveOccurred(), "threshold %v", threshold)
+		}
+		_, err := sknlinechart.ParseSmoothing("hampel:window=5,threshold=-1", "Humidity")
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,112 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"math"
+)
+
+// Interpolation how the line between consecutive datapoints is drawn
+type Interpolation int
+
+const (
+	InterpolateLinear     Interpolation = iota // straight segments
+	InterpolateStepAfter                       // value holds until the next point, then steps
+	InterpolateStepBefore                      // value steps at the previous point, then holds
+	InterpolateMonotone                        // monotone cubic spline, never overshoots the data
+)
+
+// splineSteps sub-segments drawn for each spline segment
+const splineSteps = 6
+
+// interpolatePaths returns, for each point, the path joining it to the previous point
+// the first point's path is the point itself
+func interpolatePaths(points []fyne.Position, mode Interpolation) [][]fyne.Position {
+	paths := make([][]fyne.Position, len(points))
+	if len(points) == 0 {
+		return paths
+	}
+	paths[0] = []fyne.Position{points[0], points[0]}
+
+	var tangents []float32
+	if mode == InterpolateMonotone {
+		tangents = monotoneTangents(points)
+	}
+	for idx := 1; idx < len(points); idx++ {
+		a, b := points[idx-1], points[idx]
+		switch mode {
+		case InterpolateStepAfter:
+			paths[idx] = []fyne.Position{a, fyne.NewPos(b.X, a.Y), b}
+		case InterpolateStepBefore:
+			paths[idx] = []fyne.Position{a, fyne.NewPos(a.X, b.Y), b}
+		case InterpolateMonotone:
+			paths[idx] = hermitePath(a, b, tangents[idx-1], tangents[idx])
+		default:
+			paths[idx] = []fyne.Position{a, b}
+		}
+	}
+	return paths
+}
+
+// flattenPaths joins per point paths into one edge, as used by area fills
+func flattenPaths(paths [][]fyne.Position) []fyne.Position {
+	var edge []fyne.Position
+	for idx, path := range paths {
+		if idx == 0 {
+			edge = append(edge, path[0])
+			continue
+		}
+		edge = append(edge, path[1:]...)
+	}
+	return edge
+}
+
+// monotoneTangents Fritsch-Carlson tangents keeping the spline monotone between points
+func monotoneTangents(points []fyne.Position) []float32 {
+	n := len(points)
+	tangents := make([]float32, n)
+	if n < 2 {
+		return tangents
+	}
+	slopes := make([]float32, n-1)
+	for k := 0; k < n-1; k++ {
+		dx := points[k+1].X - points[k].X
+		if dx != 0 {
+			slopes[k] = (points[k+1].Y - points[k].Y) / dx
+		}
+	}
+	tangents[0] = slopes[0]
+	tangents[n-1] = slopes[n-2]
+	for k := 1; k < n-1; k++ {
+		if slopes[k-1]*slopes[k] > 0 {
+			tangents[k] = (slopes[k-1] + slopes[k]) / 2
+		}
+	}
+	for k := 0; k < n-1; k++ {
+		if slopes[k] == 0 {
+			tangents[k], tangents[k+1] = 0, 0
+			continue
+		}
+		a := tangents[k] / slopes[k]
+		b := tangents[k+1] / slopes[k]
+		if h := a*a + b*b; h > 9 {
+			t := float32(3 / math.Sqrt(float64(h)))
+			tangents[k] = t * a * slopes[k]
+			tangents[k+1] = t * b * slopes[k]
+		}
+	}
+	return tangents
+}
+
+// hermitePath cubic hermite curve from a to b given the tangent at each end
+func hermitePath(a, b fyne.Position, ma, mb float32) []fyne.Position {
+	h := b.X - a.X
+	path := make([]fyne.Position, 0, splineSteps+1)
+	path = append(path, a)
+	for step := 1; step <= splineSteps; step++ {
+		t := float32(step) / splineSteps
+		t2, t3 := t*t, t*t*t
+		y := (2*t3-3*t2+1)*a.Y + (t3-2*t2+t)*h*ma + (-2*t3+3*t2)*b.Y + (t3-t2)*h*mb
+		path = append(path, fyne.NewPos(a.X+h*t, y))
+	}
+	return path
+}
@@ -0,0 +1,49 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"math"
+)
+
+var _ = Describe("Line interpolation", func() {
+	points := []fyne.Position{
+		fyne.NewPos(0, 50), fyne.NewPos(10, 50), fyne.NewPos(20, 10),
+		fyne.NewPos(30, 12), fyne.NewPos(40, 90), fyne.NewPos(50, 88),
+	}
+
+	It("should hold values across steps", func() {
+		paths := sknlinechart.InterpolatePaths(points[:3], sknlinechart.InterpolateStepAfter)
+		Expect(paths[0]).To(Equal([]fyne.Position{points[0], points[0]}))
+		Expect(paths[2]).To(Equal([]fyne.Position{points[1], fyne.NewPos(20, 50), points[2]}))
+
+		paths = sknlinechart.InterpolatePaths(points[:3], sknlinechart.InterpolateStepBefore)
+		Expect(paths[2]).To(Equal([]fyne.Position{points[1], fyne.NewPos(10, 10), points[2]}))
+	})
+
+	It("should never overshoot the datapoints with a monotone spline", func() {
+		paths := sknlinechart.InterpolatePaths(points, sknlinechart.InterpolateMonotone)
+		Expect(paths).To(HaveLen(len(points)))
+		for idx := 1; idx < len(points); idx++ {
+			a, b := points[idx-1], points[idx]
+			path := paths[idx]
+			Expect(path[0]).To(Equal(a))
+			Expect(path[len(path)-1].X).To(BeNumerically("~", b.X, 0.001))
+			Expect(path[len(path)-1].Y).To(BeNumerically("~", b.Y, 0.001))
+			lowY, highY := math.Min(float64(a.Y), float64(b.Y)), math.Max(float64(a.Y), float64(b.Y))
+			for step, p := range path {
+				Expect(float64(p.Y)).To(BeNumerically(">=", lowY-0.001), "segment %d step %d", idx, step)
+				Expect(float64(p.Y)).To(BeNumerically("<=", highY+0.001), "segment %d step %d", idx, step)
+				if step > 0 {
+					Expect(p.X).To(BeNumerically(">", path[step-1].X))
+				}
+			}
+		}
+		By("staying flat between equal values")
+		for _, p := range paths[1] {
+			Expect(p.Y).To(BeNumerically("~", 50, 0.001))
+		}
+	})
+})
@@ -0,0 +1,73 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/widget"
+	"image/color"
+)
+
+// legendEntry one series name in the color legend
+// tapping toggles the series visibility, hovering highlights the series
+type legendEntry struct {
+	widget.BaseWidget
+	series   string
+	text     *canvas.Text
+	onTapped func(series string)
+	onHover  func(series string, hovered bool)
+}
+
+var _ fyne.Tappable = (*legendEntry)(nil)
+var _ desktop.Hoverable = (*legendEntry)(nil)
+
+func newLegendEntry(series string, c color.Color, onTapped func(series string), onHover func(series string, hovered bool)) *legendEntry {
+	e := &legendEntry{
+		series:   series,
+		text:     canvas.NewText(series, c),
+		onTapped: onTapped,
+		onHover:  onHover,
+	}
+	e.ExtendBaseWidget(e)
+	return e
+}
+
+// CreateRenderer the entry is only its text
+func (e *legendEntry) CreateRenderer() fyne.WidgetRenderer {
+	return widget.NewSimpleRenderer(e.text)
+}
+
+// setAppearance labels and colors the entry, hidden series are shown faded
+func (e *legendEntry) setAppearance(label string, c color.Color, hidden bool) {
+	if hidden {
+		c = withAlpha(c, 0x60)
+	}
+	e.text.Text = label
+	e.text.Color = c
+	e.text.TextStyle = fyne.TextStyle{Italic: hidden}
+	e.Refresh()
+}
+
+// Tapped From the Tappable Interface, toggles series visibility
+func (e *legendEntry) Tapped(*fyne.PointEvent) {
+	if e.onTapped != nil {
+		e.onTapped(e.series)
+	}
+}
+
+// MouseIn highlight this entry's series
+func (e *legendEntry) MouseIn(*desktop.MouseEvent) {
+	if e.onHover != nil {
+		e.onHover(e.series, true)
+	}
+}
+
+// MouseMoved unused interface method
+func (e *legendEntry) MouseMoved(*desktop.MouseEvent) {}
+
+// MouseOut remove the series highlight
+func (e *legendEntry) MouseOut() {
+	if e.onHover != nil {
+		e.onHover(e.series, false)
+	}
+}
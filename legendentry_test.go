@@ -0,0 +1,64 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"image/color"
+	"time"
+)
+
+var _ = Describe("Interactive legend", func() {
+
+	var lc sknlinechart.LineChart
+	alpha := func(series string) uint8 {
+		return color.NRGBAModel.Convert(sknlinechart.SeriesSegment(lc, series, 0).StrokeColor).(color.NRGBA).A
+	}
+
+	BeforeEach(func() {
+		lc = renderedUI()
+		Expect(lc.SetSeriesSmoothing("A", sknlinechart.NewRollingMedian("A Median", 3))).To(Succeed())
+		for _, v := range []float32{20, 30, 40} {
+			for _, series := range []string{"A", "B"} {
+				point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, time.Now().Format(time.RFC1123))
+				lc.ApplyDataPoint(series, &point)
+			}
+		}
+	})
+
+	It("should toggle a series and its smoothed companion when its entry is tapped", func() {
+		sknlinechart.LegendEntry(lc, "A").Tapped(&fyne.PointEvent{})
+		Expect(lc.IsSeriesVisible("A")).To(BeFalse())
+		Expect(lc.IsSeriesVisible("A Median")).To(BeFalse())
+		Expect(lc.IsSeriesVisible("B")).To(BeTrue())
+		Expect(sknlinechart.SeriesSegment(lc, "A", 0).Visible()).To(BeFalse())
+		Expect(sknlinechart.LegendText(lc, "A").TextStyle.Italic).To(BeTrue())
+		Expect(lc.GetDataSeries("A")).To(HaveLen(3))
+
+		By("showing them again on a second tap")
+		sknlinechart.LegendEntry(lc, "A").Tapped(&fyne.PointEvent{})
+		Expect(lc.IsSeriesVisible("A")).To(BeTrue())
+		Expect(lc.IsSeriesVisible("A Median")).To(BeTrue())
+		Expect(sknlinechart.SeriesSegment(lc, "A", 0).Visible()).To(BeTrue())
+		Expect(sknlinechart.LegendText(lc, "A").TextStyle.Italic).To(BeFalse())
+	})
+
+	It("should dim the other series while an entry is hovered", func() {
+		Expect([]uint8{alpha("A"), alpha("A Median"), alpha("B")}).To(Equal([]uint8{0xff, 0xff, 0xff}))
+
+		sknlinechart.LegendEntry(lc, "B").MouseIn(&desktop.MouseEvent{})
+		Expect([]uint8{alpha("A"), alpha("A Median"), alpha("B")}).To(Equal([]uint8{0x40, 0x40, 0xff}))
+
+		By("keeping a smoothed companion highlighted with its series")
+		sknlinechart.LegendEntry(lc, "B").MouseOut()
+		sknlinechart.LegendEntry(lc, "A").MouseIn(&desktop.MouseEvent{})
+		Expect([]uint8{alpha("A"), alpha("A Median"), alpha("B")}).To(Equal([]uint8{0xff, 0xff, 0x40}))
+
+		By("restoring every series when the mouse leaves")
+		sknlinechart.LegendEntry(lc, "A").MouseOut()
+		Expect([]uint8{alpha("A"), alpha("A Median"), alpha("B")}).To(Equal([]uint8{0xff, 0xff, 0xff}))
+	})
+})
@@ -8,9 +8,12 @@ import (
 	"fyne.io/fyne/v2/driver/desktop"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+	"image/color"
 	"log"
 	"os"
+	"sort"
 	"sync"
+	"text/template"
 	"time"
 )
 
@@ -80,7 +83,7 @@ type LineChartSkn struct {
 	bottomRightLabel        string
 	mouseDisplayStr         string
 	mouseDisplayPosition    *fyne.Position
-	mouseDisplayFrameColor  string
+	mouseDisplayFrameColor  color.Color
 	dataPoints              map[string][]*ChartDatapoint
 	minSize                 fyne.Size
 	mapsLock                sync.RWMutex
@@ -89,12 +92,55 @@ type LineChartSkn struct {
 	// Private: Exposed for Testing; DO NOT USE
 	objectsCache         []fyne.CanvasObject
 	OnHoverPointCallback func(series string, dataPoint ChartDatapoint)
+	hoverFormatter       HoverFormatter
+	hoverTemplate        *template.Template
+	// Range selection by dragging
+	OnPointTappedCallback   func(series string, dataPoint ChartDatapoint)
+	OnRangeSelectedCallback func(start, end int, pointsBySeries map[string][]ChartDatapoint)
+	enableRangeSelection    bool
+	selectionDragging       bool
+	selectionShown          bool
+	selectionStartX         float32
+	selectionEndX           float32
+	// Keyboard cursor
+	hasFocus          bool
+	liveFollow        bool
+	cursorSeries      string
+	cursorIndex       int
+	zoomHistory       []zoomStep // y scale factors zoomed in from, most recent last
+	seriesLayoutStale bool
+	// Legend interaction
+	hiddenSeries      map[string]bool
+	highlightedSeries string
+	// Legend placement
+	legendPosition        LegendPosition
+	legendVertical        bool
+	enableLegendLastValue bool
+	seriesUnits           map[string]string
+	seriesStyles          map[string]SeriesStyle
+	colorPalette          []color.Color
+	seriesPaletteColors   map[string]color.Color
+	xyPlot                *XYPlot
+	thresholds            []threshold
+	zones                 []zone
+	eventMarkers          []chartEvent
+	annotations           []chartEvent
+	// Alerts
+	OnAlertCallback func(rule AlertRule, series string, dataPoint ChartDatapoint)
+	alertRules      []*alertState
+	alertColors     map[string]color.Color // by datapoint ExternalID
+	derivers        []seriesDeriver
+	linkedSeries    map[string]string // smoothed companion to its series
 }
 
 var _ LineChart = (*LineChartSkn)(nil)
 var _ fyne.Widget = (*LineChartSkn)(nil)
 var _ fyne.CanvasObject = (*LineChartSkn)(nil)
 
+// the chart takes every drag, even with range selection off, so drags over it
+// do not scroll an enclosing container.Scroll; its mouse wheel scrolling is unaffected
+var _ fyne.Draggable = (*LineChartSkn)(nil)
+
 // NewLineChart Create the Line Chart
 // be careful not to exceed the series data point limit, which defaults to 150
 //
@@ -134,9 +180,17 @@ func New(topTitle, bottomTitle string, yScaleFactor int, dataPoints *map[string]
 		enableVertGridLines:     true,
 		enableMousePointDisplay: true,
 		enableColorLegend:       true,
+		liveFollow:              true,
+		hiddenSeries:            map[string]bool{},
+		seriesUnits:             map[string]string{},
+		seriesStyles:            map[string]SeriesStyle{},
+		colorPalette:            ColorBlindSafePalette,
+		seriesPaletteColors:     map[string]color.Color{},
+		alertColors:             map[string]color.Color{},
+		linkedSeries:            map[string]string{},
 		mouseDisplayStr:         "",
 		mouseDisplayPosition:    &fyne.Position{},
-		mouseDisplayFrameColor:  string(theme.ColorNameForeground),
+		mouseDisplayFrameColor:  theme.ForegroundColor(),
 		topLeftLabel:            "",
 		topCenteredLabel:        topTitle,
 		topRightLabel:           "",
@@ -150,6 +204,7 @@ func New(topTitle, bottomTitle string, yScaleFactor int, dataPoints *map[string]
 		mapsLock:                sync.RWMutex{},
 		logger:                  log.New(os.Stdout, "[DEBUG] ", log.Lmicroseconds|log.Lshortfile),
 	}
+	w.assignPaletteColors()
 	w.ExtendBaseWidget(w) // Initialize the BaseWidget
 	return w, err
 }
@@ -167,6 +222,159 @@ func (w *LineChartSkn) SetOnHoverPointCallback(f func(series string, dataPoint C
 	w.OnHoverPointCallback = f
 }
 
+// SetOnPointTappedCallback method to call when a onscreen datapoint is tapped with mouse button one
+func (w *LineChartSkn) SetOnPointTappedCallback(f func(series string, dataPoint ChartDatapoint)) {
+	w.OnPointTappedCallback = f
+}
+
+// SetOnRangeSelectedCallback method to call when a drag selection of datapoints completes
+func (w *LineChartSkn) SetOnRangeSelectedCallback(f func(start, end int, pointsBySeries map[string][]ChartDatapoint)) {
+	w.OnRangeSelectedCallback = f
+}
+
+// IsRangeSelectionEnabled returns state of drag to select ranges
+func (w *LineChartSkn) IsRangeSelectionEnabled() bool {
+	return w.enableRangeSelection
+}
+
+// SetRangeSelection enables drag to select a range of datapoints; the chart
+// takes drags whether or not selection is enabled
+func (w *LineChartSkn) SetRangeSelection(enable bool) {
+	w.enableRangeSelection = enable
+	if !enable {
+		w.ClearRangeSelection()
+	}
+}
+
+// ClearRangeSelection removes the shaded selection from the chart
+func (w *LineChartSkn) ClearRangeSelection() {
+	w.selectionDragging = false
+	w.selectionShown = false
+	w.Refresh()
+}
+
+// SetHoverFormatter replaces the default hover popup text; nil restores the default
+func (w *LineChartSkn) SetHoverFormatter(f HoverFormatter) {
+	w.mapsLock.Lock()
+	w.hoverFormatter = f
+	w.mapsLock.Unlock()
+}
+
+// SetHoverTemplate renders the hover popup text from a template given HoverData; nil restores the default
+func (w *LineChartSkn) SetHoverTemplate(t *template.Template) {
+	w.mapsLock.Lock()
+	w.hoverTemplate = t
+	w.mapsLock.Unlock()
+}
+
+// GetYScaleFactor returns the value of each of the 13 y scale divisions
+func (w *LineChartSkn) GetYScaleFactor() int {
+	return w.chartScaleMultiplier
+}
+
+// SetYScaleFactor changes the value of each of the 13 y scale divisions, max y scale is factor times 13
+func (w *LineChartSkn) SetYScaleFactor(yScaleFactor int) {
+	if yScaleFactor < 1 {
+		yScaleFactor = 1
+	}
+	w.mapsLock.Lock()
+	w.chartScaleMultiplier = yScaleFactor
+	w.dataPointYLimit = float32(yScaleFactor * 13)
+	w.seriesLayoutStale = true
+	w.mapsLock.Unlock()
+}
+
+// validate rejects positions other than the LegendPosition constants
+func (p LegendPosition) validate() error {
+	if p < LegendBottom || p > LegendOverlay {
+		return fmt.Errorf("legend position %d is not supported", p)
+	}
+	return nil
+}
+
+// SetLegendPosition moves the color legend, left and right placements are always vertical
+func (w *LineChartSkn) SetLegendPosition(position LegendPosition) error {
+	if err := position.validate(); err != nil {
+		return err
+	}
+	w.mapsLock.Lock()
+	w.legendPosition = position
+	w.mapsLock.Unlock()
+	w.Refresh()
+	return nil
+}
+
+// SetLegendVertical stacks the color legend entries vertically
+func (w *LineChartSkn) SetLegendVertical(enable bool) {
+	w.mapsLock.Lock()
+	w.legendVertical = enable
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// SetLegendLastValue shows each series' latest value, and unit, in its legend entry
+func (w *LineChartSkn) SetLegendLastValue(enable bool) {
+	w.mapsLock.Lock()
+	w.enableLegendLastValue = enable
+	w.seriesLayoutStale = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// SetSeriesUnit sets the unit shown after the series' latest value in the legend
+func (w *LineChartSkn) SetSeriesUnit(series, unit string) {
+	w.mapsLock.Lock()
+	w.seriesUnits[series] = unit
+	w.seriesLayoutStale = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// IsSeriesVisible returns false when the series has been hidden
+func (w *LineChartSkn) IsSeriesVisible(series string) bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return !w.hiddenSeries[series]
+}
+
+// SetSeriesVisible hides or shows a series without discarding its data; tapping its legend entry toggles
+func (w *LineChartSkn) SetSeriesVisible(series string, visible bool) {
+	w.mapsLock.Lock()
+	if visible {
+		delete(w.hiddenSeries, series)
+	} else {
+		w.hiddenSeries[series] = true
+	}
+	w.seriesLayoutStale = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// toggleSeriesVisible legend entry tap handler, smoothed companions follow their series
+func (w *LineChartSkn) toggleSeriesVisible(series string) {
+	visible := !w.IsSeriesVisible(series)
+	w.mapsLock.RLock()
+	companions := w.companions(series)
+	w.mapsLock.RUnlock()
+	for _, companion := range companions {
+		w.SetSeriesVisible(companion, visible)
+	}
+	w.SetSeriesVisible(series, visible)
+}
+
+// highlightSeries legend entry hover handler, dims all other series while hovered
+func (w *LineChartSkn) highlightSeries(series string, hovered bool) {
+	w.mapsLock.Lock()
+	if hovered {
+		w.highlightedSeries = series
+	} else if w.highlightedSeries == series {
+		w.highlightedSeries = ""
+	}
+	w.seriesLayoutStale = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
 // SetMinSize set the minimum size limit for the linechart
 func (w *LineChartSkn) SetMinSize(s fyne.Size) {
 	w.debugLog("LineChartSkn::SetMinSize()")
@@ -245,7 +453,212 @@ func (w *LineChartSkn) GetBottomRightLabel() string {
 
 // SetLineStrokeSize sets thickness of all lines drawn
 func (w *LineChartSkn) SetLineStrokeSize(newSize float32) {
+	w.mapsLock.Lock()
 	w.dataPointStrokeSize = newSize
+	w.seriesLayoutStale = true
+	w.mapsLock.Unlock()
+}
+
+// GetSeriesStyle returns the style set for the series, zero values are chart defaults
+func (w *LineChartSkn) GetSeriesStyle(series string) SeriesStyle {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.seriesStyles[series]
+}
+
+// SetSeriesStyle sets color, stroke width, dash pattern, marker shape/size and opacity of a series
+func (w *LineChartSkn) SetSeriesStyle(series string, style SeriesStyle) error {
+	if err := style.validate(series); err != nil {
+		return err
+	}
+	w.mapsLock.Lock()
+	w.seriesStyles[series] = style
+	w.seriesLayoutStale = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+	return nil
+}
+
+// AddThreshold draws a labeled horizontal reference line at the value, behind the series;
+// a nil color uses the foreground color
+func (w *LineChartSkn) AddThreshold(value float32, label string, c color.Color, axis ScaleAxis) {
+	if c == nil {
+		c = theme.ForegroundColor()
+	}
+	w.mapsLock.Lock()
+	w.thresholds = append(w.thresholds, threshold{value: value, label: label, color: c, axis: axis})
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// AddZone shades the band between min and max behind the series, opaque colors are made translucent
+// and a nil color uses the foreground color
+func (w *LineChartSkn) AddZone(min, max float32, c color.Color, label string) {
+	if c == nil {
+		c = theme.ForegroundColor()
+	}
+	w.mapsLock.Lock()
+	w.zones = append(w.zones, zone{min: min, max: max, color: c, label: label})
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// ClearThresholds removes all threshold lines
+func (w *LineChartSkn) ClearThresholds() {
+	w.mapsLock.Lock()
+	w.thresholds = nil
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// ClearZones removes all shaded zones
+func (w *LineChartSkn) ClearZones() {
+	w.mapsLock.Lock()
+	w.zones = nil
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// AddEventMarker draws a labeled vertical line at a series datapoint, given by index,
+// time.Time or timestamp string, which scrolls with the data until the datapoint rolls off
+func (w *LineChartSkn) AddEventMarker(series string, at any, label string, c color.Color) error {
+	if c == nil {
+		c = theme.ForegroundColor()
+	}
+	w.mapsLock.Lock()
+	id, err := eventAnchor(series, w.dataPoints[series], at)
+	if err == nil {
+		w.eventMarkers = append(w.eventMarkers, chartEvent{externalID: id, label: label, color: c})
+	}
+	w.mapsLock.Unlock()
+	if err != nil {
+		return err
+	}
+	w.Refresh()
+	return nil
+}
+
+// AddAnnotation shows text above the datapoint with the ExternalID until it rolls off
+func (w *LineChartSkn) AddAnnotation(externalID, text string, c color.Color) error {
+	if c == nil {
+		c = theme.ForegroundColor()
+	}
+	w.mapsLock.Lock()
+	_, found, _ := w.anchoredPoint(externalID)
+	if found {
+		w.annotations = append(w.annotations, chartEvent{externalID: externalID, label: text, color: c})
+	}
+	w.mapsLock.Unlock()
+	if !found {
+		return fmt.Errorf("no datapoint with external id %s", externalID)
+	}
+	w.Refresh()
+	return nil
+}
+
+// ClearEventMarkers removes all event markers
+func (w *LineChartSkn) ClearEventMarkers() {
+	w.mapsLock.Lock()
+	w.eventMarkers = nil
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// ClearAnnotations removes all annotations
+func (w *LineChartSkn) ClearAnnotations() {
+	w.mapsLock.Lock()
+	w.annotations = nil
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// GetXYPlot returns the active XY plot, nil when showing the time series
+func (w *LineChartSkn) GetXYPlot() *XYPlot {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	if w.xyPlot == nil {
+		return nil
+	}
+	plot := *w.xyPlot
+	return &plot
+}
+
+// SetXYPlot plots one series' values against another's, nil returns to the time series
+func (w *LineChartSkn) SetXYPlot(plot *XYPlot) {
+	w.mapsLock.Lock()
+	if plot != nil {
+		p := *plot
+		plot = &p
+	}
+	w.xyPlot = plot
+	w.seriesLayoutStale = true
+	w.mapsLock.Unlock()
+	w.Refresh()
+}
+
+// seriesStyle the series' style with chart defaults applied; caller must hold the maps lock
+func (w *LineChartSkn) seriesStyle(series string) SeriesStyle {
+	return w.seriesStyles[series].resolved(w.dataPointStrokeSize)
+}
+
+// seriesBaseColor resolves a datapoint's color from the series style, then the datapoint,
+// falling back to the series' palette color; caller must hold the maps lock
+func (w *LineChartSkn) seriesBaseColor(series string, point ChartDatapoint) color.Color {
+	if c, ok := w.alertColors[point.ExternalID()]; ok {
+		return c
+	}
+	style := w.seriesStyles[series]
+	if style.Color != nil {
+		return style.Color
+	}
+	if c, ok := colorFromName(style.ColorName); ok {
+		return c
+	}
+	if point.Color() != nil {
+		return point.Color()
+	}
+	if c, ok := colorFromName(point.ColorName()); ok {
+		return c
+	}
+	return w.paletteColor(series)
+}
+
+// paletteColor the palette color assigned to the series, assigning the next one on first use
+func (w *LineChartSkn) paletteColor(series string) color.Color {
+	if c, ok := w.seriesPaletteColors[series]; ok {
+		return c
+	}
+	if len(w.colorPalette) == 0 {
+		return theme.PrimaryColor()
+	}
+	c := w.colorPalette[len(w.seriesPaletteColors)%len(w.colorPalette)]
+	w.seriesPaletteColors[series] = c
+	return c
+}
+
+// assignPaletteColors gives series without a palette color the next ones in series name
+// order, so map iteration order never changes a chart's colors; caller holds the maps lock
+func (w *LineChartSkn) assignPaletteColors() {
+	names := make([]string, 0, len(w.dataPoints))
+	for key := range w.dataPoints {
+		if _, ok := w.seriesPaletteColors[key]; !ok {
+			names = append(names, key)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		w.paletteColor(name)
+	}
+}
+
+// seriesColor resolves the color a datapoint is drawn with, including the series' opacity
+func (w *LineChartSkn) seriesColor(series string, point ChartDatapoint) color.Color {
+	c := w.seriesBaseColor(series, point)
+	if opacity := w.seriesStyle(series).Opacity; opacity < 1 {
+		_, _, _, a := c.RGBA()
+		c = withAlpha(c, uint8(float32(a>>8)*opacity))
+	}
+	return c
 }
 
 // SetTopLeftLabel sets text to be display on chart at top left
@@ -327,6 +740,7 @@ func (w *LineChartSkn) ApplyDataSeries(seriesName string, newSeries []*ChartData
 	if len(newSeries) <= w.dataPointXLimit {
 		w.mapsLock.Lock()
 		w.dataPoints[seriesName] = newSeries
+		w.paletteColor(seriesName)
 		w.dataSeriesAdded = true
 		w.mapsLock.Unlock()
 		w.Refresh()
@@ -349,26 +763,121 @@ func (w *LineChartSkn) ApplyDataPoint(seriesName string, newDataPoint *ChartData
 	}
 
 	w.mapsLock.Lock()
-
-	if len(w.dataPoints[seriesName]) <= w.dataPointXLimit {
-		w.dataPoints[seriesName] = append(w.dataPoints[seriesName], newDataPoint)
-	} else {
-		w.dataPoints[seriesName] = ShiftSlice(newDataPoint, w.dataPoints[seriesName])
+	raised := w.appendDataPoint(seriesName, newDataPoint, 0)
+	if len(raised) > 0 {
+		w.seriesLayoutStale = true // recolors earlier points
 	}
 	w.datapointAdded = true
 	w.mapsLock.Unlock()
 	w.Refresh()
+	w.followCursor(seriesName)
+	w.raiseAlerts(raised)
 	w.debugLog("LineChartSkn::ApplyDataPoint() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 }
 
+// GetDataSeries copies of the series' current datapoints, oldest first
+func (w *LineChartSkn) GetDataSeries(seriesName string) []ChartDatapoint {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	points := make([]ChartDatapoint, 0, len(w.dataPoints[seriesName]))
+	for _, point := range w.dataPoints[seriesName] {
+		points = append(points, (*point).Copy())
+	}
+	return points
+}
+
 // Tapped From the Tappable Interface
-func (w *LineChartSkn) Tapped(*fyne.PointEvent) {
+// a tapped datapoint goes to the point tapped callback, otherwise
+// an active range selection is cleared or the hover popup is toggled
+func (w *LineChartSkn) Tapped(pe *fyne.PointEvent) {
 	w.debugLog("LineChartSkn::Tapped() ENTER")
+	w.requestFocus()
+	if w.OnPointTappedCallback != nil {
+		w.mapsLock.RLock()
+		series, _, point, found := w.datapointAt(pe.Position)
+		w.mapsLock.RUnlock()
+		if found {
+			w.OnPointTappedCallback(series, point)
+			w.debugLog("LineChartSkn::Tapped(point) EXIT")
+			return
+		}
+	}
+	if w.selectionShown {
+		w.ClearRangeSelection()
+		w.debugLog("LineChartSkn::Tapped(selection) EXIT")
+		return
+	}
 	w.enableMousePointDisplay = !w.enableMousePointDisplay
 	w.Refresh()
 	w.debugLog("LineChartSkn::Tapped() EXIT")
 }
 
+// Dragged From the Draggable Interface, tracks the range being selected
+func (w *LineChartSkn) Dragged(de *fyne.DragEvent) {
+	if !w.enableRangeSelection {
+		return
+	}
+	if !w.selectionDragging {
+		w.selectionDragging = true
+		w.selectionShown = true
+		w.selectionStartX = de.Position.X - de.Dragged.DX
+	}
+	w.selectionEndX = de.Position.X
+	w.Refresh()
+}
+
+// DragEnd From the Draggable Interface, collects the selected points and fires the range callback
+func (w *LineChartSkn) DragEnd() {
+	startTime := time.Now()
+	w.debugLog("LineChartSkn::DragEnd() ENTER")
+	if !w.enableRangeSelection || !w.selectionDragging {
+		return
+	}
+	w.selectionDragging = false
+
+	minX, maxX := w.selectionStartX, w.selectionEndX
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	start, end := -1, -1
+	selected := map[string][]ChartDatapoint{}
+
+	w.mapsLock.RLock()
+	for key, points := range w.dataPoints {
+		if w.hiddenSeries[key] || (w.xyPlot != nil && key != w.xyPlot.YSeries) {
+			continue
+		}
+		for idx, point := range points {
+			top, bottom := (*point).MarkerPosition()
+			if top.IsZero() {
+				continue
+			}
+			x := (top.X + bottom.X) / 2
+			if x >= minX && x <= maxX {
+				selected[strings.Clone(key)] = append(selected[key], (*point).Copy())
+				if start < 0 || idx < start {
+					start = idx
+				}
+				if idx > end {
+					end = idx
+				}
+			}
+		}
+	}
+	w.mapsLock.RUnlock()
+
+	if len(selected) == 0 {
+		w.ClearRangeSelection()
+		w.debugLog("LineChartSkn::DragEnd(empty) EXIT")
+		return
+	}
+	if w.OnRangeSelectedCallback != nil {
+		w.OnRangeSelectedCallback(start, end, selected)
+	}
+	w.Refresh()
+	w.debugLog("LineChartSkn::DragEnd() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
+}
+
 // TappedSecondary From the SecondaryTappable Interface
 func (w *LineChartSkn) TappedSecondary(*fyne.PointEvent) {
 	w.debugLog("LineChartSkn::TappedSecondary() ENTER")
@@ -391,33 +900,101 @@ func (w *LineChartSkn) MouseMoved(me *desktop.MouseEvent) {
 		w.debugLog("LineChartSkn::MouseMoved(disabled) EXIT")
 		return
 	}
-	w.mapsLock.Lock()
-	matched := false
+	w.mapsLock.RLock()
+	key, idx, point, matched := w.datapointAt(me.Position)
+	w.mapsLock.RUnlock()
+	if matched {
+		w.showHover(key, idx, point, me.Position)
+	}
+	w.debugLog("LineChartSkn::MouseMoved() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
+}
 
-found:
+// datapointAt finds the datapoint whose marker is under the given position
+// returns a copy of the point; caller must hold the maps lock
+func (w *LineChartSkn) datapointAt(pos fyne.Position) (string, int, ChartDatapoint, bool) {
+	if pos.IsZero() {
+		return "", 0, nil, false
+	}
 	for key, points := range w.dataPoints {
+		if w.hiddenSeries[key] || (w.xyPlot != nil && key != w.xyPlot.YSeries) {
+			continue
+		}
 		for idx, point := range points {
 			top, bottom := (*point).MarkerPosition()
-			if !me.Position.IsZero() && !top.IsZero() {
-				if me.Position.X > top.X && me.Position.X < bottom.X &&
-					me.Position.Y > top.Y-1 && me.Position.Y < bottom.Y {
-					w.debugLog("MouseMoved() matched Mouse: ", me.Position, ", Top: ", top, ", Bottom: ", bottom)
-					value := fmt.Sprint(key, ", Index: ", idx, ", Value: ", (*point).Value(), "    [", (*point).Timestamp(), "]")
-					w.enableMouseContainer(value, (*point).ColorName(), &me.Position)
-					if w.OnHoverPointCallback != nil {
-						w.OnHoverPointCallback(strings.Clone(key), (*point).Copy())
-					}
-					matched = true
-					break found
+			if !top.IsZero() {
+				if pos.X > top.X && pos.X < bottom.X &&
+					pos.Y > top.Y-1 && pos.Y < bottom.Y {
+					w.debugLog("datapointAt() matched Mouse: ", pos, ", Top: ", top, ", Bottom: ", bottom)
+					return strings.Clone(key), idx, (*point).Copy(), true
 				}
 			}
 		}
 	}
+	return "", 0, nil, false
+}
+
+// showHover fills the popup for the copied datapoint and runs the hover callback,
+// user formatters and callbacks run without the maps lock so they may call chart getters
+func (w *LineChartSkn) showHover(series string, idx int, point ChartDatapoint, pos fyne.Position) {
+	value := w.hoverText(series, idx, point)
+	w.mapsLock.Lock()
+	w.enableMouseContainer(value, w.seriesBaseColor(series, point), &pos)
 	w.mapsLock.Unlock()
-	if matched {
-		w.Refresh()
+	if w.OnHoverPointCallback != nil {
+		w.OnHoverPointCallback(series, point)
 	}
-	w.debugLog("LineChartSkn::MouseMoved() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
+	w.Refresh()
+}
+
+// hoverText composes the popup text for a datapoint
+// formatter wins over template, template errors fall back to the default text
+func (w *LineChartSkn) hoverText(series string, idx int, point ChartDatapoint) string {
+	w.mapsLock.RLock()
+	formatter, tmpl := w.hoverFormatter, w.hoverTemplate
+	w.mapsLock.RUnlock()
+	if formatter != nil {
+		return formatter(series, idx, point)
+	}
+	low, high := bandBounds(point)
+	open, _, _, _, ohlc := point.OHLC()
+	minus, plus, uncertain := point.Uncertainty()
+	if !ohlc {
+		open = point.Value()
+	}
+	if tmpl != nil {
+		var sb strings.Builder
+		err := tmpl.Execute(&sb, HoverData{
+			Series:    series,
+			Index:     idx,
+			Value:     point.Value(),
+			Open:      open,
+			Low:       low,
+			High:      high,
+			Minus:     minus,
+			Plus:      plus,
+			Timestamp: point.Timestamp(),
+			ColorName: point.ColorName(),
+			Metadata:  point.Metadata(),
+			Point:     point,
+		})
+		if err == nil {
+			return sb.String()
+		}
+		w.debugLog("LineChartSkn::hoverText() template error: ", err.Error())
+	}
+	if ohlc {
+		return fmt.Sprint(series, ", Index: ", idx, ", Open: ", open, ", High: ", high, ", Low: ", low, ", Close: ", point.Value(), "    [", point.Timestamp(), "]")
+	}
+	if _, _, ok := point.Band(); ok {
+		return fmt.Sprint(series, ", Index: ", idx, ", Low: ", low, ", Mid: ", point.Value(), ", High: ", high, "    [", point.Timestamp(), "]")
+	}
+	if uncertain {
+		if minus == plus {
+			return fmt.Sprint(series, ", Index: ", idx, ", Value: ", point.Value(), " ±", plus, "    [", point.Timestamp(), "]")
+		}
+		return fmt.Sprint(series, ", Index: ", idx, ", Value: ", point.Value(), " +", plus, "/-", minus, "    [", point.Timestamp(), "]")
+	}
+	return fmt.Sprint(series, ", Index: ", idx, ", Value: ", point.Value(), "    [", point.Timestamp(), "]")
 }
 
 // MouseOut disable display of mouse data point display
@@ -428,13 +1005,13 @@ func (w *LineChartSkn) MouseOut() {
 
 // enableMouseContainer private method to prepare values need by renderer to create pop display
 // composes display text, captures position and colorName for use by renderer
-func (w *LineChartSkn) enableMouseContainer(value, frameColor string, mousePosition *fyne.Position) *LineChartSkn {
+func (w *LineChartSkn) enableMouseContainer(value string, frameColor color.Color, mousePosition *fyne.Position) *LineChartSkn {
 	startTime := time.Now()
 	w.debugLog("LineChartSkn::enableMouseContainer() ENTER")
 
 	w.mouseDisplayStr = value
 	w.mouseDisplayFrameColor = frameColor
-	ct := canvas.NewText(value, theme.PrimaryColorNamed(frameColor))
+	ct := canvas.NewText(value, frameColor)
 	parts := strings.Split(value, "[")
 	ts := fyne.MeasureText(parts[0], ct.TextSize, ct.TextStyle)
 	mp := &fyne.Position{X: mousePosition.X - (ts.Width / 2), Y: mousePosition.Y - (3 * ts.Height) - theme.Padding()}
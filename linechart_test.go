@@ -1,13 +1,20 @@
 package sknlinechart_test
 
 import (
-	_ "fyne.io/fyne/v2/test"
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/driver/desktop"
+	"fyne.io/fyne/v2/test"
 	"fyne.io/fyne/v2/theme"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/skoona/sknlinechart"
+	"image/color"
 	"math/rand"
 	"reflect"
+	"strings"
+	"text/template"
 	"time"
 )
 
@@ -40,6 +47,525 @@ var _ = Describe("verify line chart initial state", func() {
 		Expect(actual.Width).To(BeNumerically(">=", float32(320.0)))
 	})
 
+	It("should give series the same palette colors every time a chart is built", func() {
+		for i := 0; i < 20; i++ {
+			dataPoints := map[string][]*sknlinechart.ChartDatapoint{}
+			for _, name := range []string{"Delta", "Alpha", "Charlie", "Bravo"} {
+				point := sknlinechart.NewChartDatapoint(10, "", time.Now().Format(time.RFC1123))
+				dataPoints[name] = append(dataPoints[name], &point)
+			}
+			lc, err := sknlinechart.NewLineChart("Palette", "Colors", 10, &dataPoints)
+			Expect(err).NotTo(HaveOccurred())
+			test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn))
+			point := sknlinechart.NewChartDatapoint(10, "", time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("Echo", &point)
+
+			for idx, name := range []string{"Alpha", "Bravo", "Charlie", "Delta", "Echo"} {
+				Expect(sknlinechart.SeriesColor(lc, name)).To(Equal(sknlinechart.ColorBlindSafePalette[idx]), name)
+			}
+		}
+	})
+
+	It("should make room for side legends and give it back", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 4)
+		point := sknlinechart.NewChartDatapoint(40, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("A much longer series name", &point)
+		lc.Resize(fyne.NewSize(800, 400))
+		lc.Refresh()
+		offset, column := sknlinechart.PlotGeometry(lc)
+		Expect(offset).To(BeZero())
+		legend := sknlinechart.ColorLegend(lc)
+		horizontal := legend.Size()
+
+		By("shifting the plot right of a left legend stacked vertically")
+		Expect(lc.SetLegendPosition(sknlinechart.LegendLeft)).To(Succeed())
+		leftOffset, leftColumn := sknlinechart.PlotGeometry(lc)
+		Expect(leftOffset).To(BeNumerically(">", 0))
+		Expect(leftColumn).To(BeNumerically("<", column))
+		Expect(legend.Size().Width).To(BeNumerically("<", horizontal.Width))
+		Expect(legend.Size().Height).To(BeNumerically(">", horizontal.Height))
+
+		By("keeping the narrower plot at the left edge for a right legend")
+		Expect(lc.SetLegendPosition(sknlinechart.LegendRight)).To(Succeed())
+		rightOffset, rightColumn := sknlinechart.PlotGeometry(lc)
+		Expect(rightOffset).To(BeZero())
+		Expect(rightColumn).To(Equal(leftColumn))
+
+		By("restoring the full plot when the legend returns to the bottom")
+		Expect(lc.SetLegendPosition(sknlinechart.LegendBottom)).To(Succeed())
+		bottomOffset, bottomColumn := sknlinechart.PlotGeometry(lc)
+		Expect(bottomOffset).To(Equal(offset))
+		Expect(bottomColumn).To(Equal(column))
+		Expect(legend.Size()).To(Equal(horizontal))
+	})
+
+	It("should compose the hover popup from the formatter or template", func() {
+		lc := renderedUI()
+		point := sknlinechart.NewChartDatapoint(42, theme.ColorBlue, "Mon, 02 Jan 2006 15:04:05 MST")
+		lc.ApplyDataPoint("Testing", &point)
+		hover := func() string { return hoverOver(lc, point) }
+		Expect(hover()).To(Equal("Testing, Index: 0, Value: 42    [Mon, 02 Jan 2006 15:04:05 MST]"))
+
+		By("running a formatter that reads the chart without deadlocking")
+		lc.SetHoverFormatter(func(series string, index int, dataPoint sknlinechart.ChartDatapoint) string {
+			return fmt.Sprintf("%s has %d points", series, len(lc.GetDataSeries(series)))
+		})
+		Expect(hover()).To(Equal("Testing has 1 points"))
+
+		By("executing a template once the formatter is removed")
+		lc.SetHoverFormatter(nil)
+		lc.SetHoverTemplate(template.Must(template.New("hover").Parse("{{.Series}} = {{.Value}}")))
+		Expect(hover()).To(Equal("Testing = 42"))
+
+		By("falling back to the default text when the template fails")
+		lc.SetHoverTemplate(template.Must(template.New("hover").Parse("{{.Missing}}")))
+		Expect(hover()).To(HavePrefix("Testing, Index: 0, Value: 42"))
+	})
+
+	It("should show each series' latest value and unit in the legend", func() {
+		lc := renderedUI()
+		apply := func(series string, v float32) {
+			point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint(series, &point)
+		}
+		lc.SetLegendLastValue(true)
+		lc.SetSeriesUnit("Temperature", "°F")
+		apply("Temperature", 71.3)
+		apply("Humidity", 40)
+		Expect(sknlinechart.LegendText(lc, "Temperature").Text).To(Equal("Temperature: 71.3 °F"))
+		Expect(sknlinechart.LegendText(lc, "Humidity").Text).To(Equal("Humidity: 40.0"))
+
+		By("updating the value as datapoints are applied")
+		apply("Temperature", 72)
+		Expect(sknlinechart.LegendText(lc, "Temperature").Text).To(Equal("Temperature: 72.0 °F"))
+
+		By("showing only the series name once disabled")
+		lc.SetLegendLastValue(false)
+		Expect(sknlinechart.LegendText(lc, "Temperature").Text).To(Equal("Temperature"))
+	})
+
+	It("should stack or overlay the legend and reject unknown positions", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 4)
+		point := sknlinechart.NewChartDatapoint(40, theme.ColorRed, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Another", &point)
+		lc.Resize(fyne.NewSize(800, 400))
+		lc.Refresh()
+		legend := sknlinechart.ColorLegend(lc)
+		horizontal := legend.Size()
+		Expect(sknlinechart.LegendFrame(lc).Visible()).To(BeFalse())
+
+		By("stacking the entries when vertical")
+		lc.SetLegendVertical(true)
+		Expect(legend.Size().Width).To(BeNumerically("<", horizontal.Width))
+		Expect(legend.Size().Height).To(BeNumerically(">", horizontal.Height))
+
+		By("framing the legend inside the plot's top right corner when overlaid")
+		Expect(lc.SetLegendPosition(sknlinechart.LegendOverlay)).To(Succeed())
+		offset, column := sknlinechart.PlotGeometry(lc)
+		Expect(offset).To(BeZero())
+		Expect(legend.Position().X + legend.Size().Width).To(BeNumerically("<=", offset+column*16))
+		Expect(legend.Position().X).To(BeNumerically(">", offset+column*8))
+		frame := sknlinechart.LegendFrame(lc)
+		Expect(frame.Visible()).To(BeTrue())
+		Expect(frame.Position().X).To(BeNumerically("<", legend.Position().X))
+		Expect(frame.Size().Width).To(BeNumerically(">", legend.Size().Width))
+
+		By("rejecting positions that are not legend positions")
+		Expect(lc.SetLegendPosition(sknlinechart.LegendPosition(42))).NotTo(Succeed())
+		Expect(lc.SetLegendPosition(sknlinechart.LegendPosition(-1))).NotTo(Succeed())
+		_, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithLegendPosition(42)))
+		Expect(err).To(HaveOccurred())
+		Expect(frame.Visible()).To(BeTrue())
+	})
+
+	It("should reject nil hover formatters and templates as options", func() {
+		_, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithHoverFormatter(nil)))
+		Expect(err).To(MatchError(ContainSubstring("hover formatter cannot be nil")))
+		_, err = sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(sknlinechart.WithHoverTemplate(nil)))
+		Expect(err).To(MatchError(ContainSubstring("hover template cannot be nil")))
+	})
+
+	It("should draw markers in the series style shape", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 4)
+		renderer := test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn))
+		triangles := func() int {
+			count := 0
+			for _, o := range renderer.Objects() {
+				if c, ok := o.(*fyne.Container); ok && len(c.Objects) == 3 {
+					if _, ok := c.Objects[0].(*canvas.Line); ok {
+						count++
+					}
+				}
+			}
+			return count
+		}
+		Expect(triangles()).To(BeZero())
+		Expect(lc.SetSeriesStyle("Testing", sknlinechart.SeriesStyle{MarkerShape: sknlinechart.MarkerTriangle, MarkerSize: 8})).To(Succeed())
+		renderer.Refresh()
+		Expect(triangles()).To(Equal(4))
+
+		By("rejecting styles that cannot be drawn")
+		Expect(lc.SetSeriesStyle("Testing", sknlinechart.SeriesStyle{Opacity: 1.5})).To(HaveOccurred())
+		Expect(lc.SetSeriesStyle("Testing", sknlinechart.SeriesStyle{DashPattern: []float32{6, -3}})).To(HaveOccurred())
+		Expect(lc.SetSeriesStyle("Testing", sknlinechart.SeriesStyle{DashPattern: []float32{0, 0}})).To(HaveOccurred())
+		Expect(lc.GetSeriesStyle("Testing").MarkerShape).To(Equal(sknlinechart.MarkerTriangle))
+	})
+
+	It("should split lines into the dashes of the pattern", func() {
+		var phase float32
+		dashes := sknlinechart.DashSegments(fyne.NewPos(0, 0), fyne.NewPos(20, 0), []float32{6, 4}, &phase)
+		Expect(dashes).To(Equal([][2]fyne.Position{
+			{fyne.NewPos(0, 0), fyne.NewPos(6, 0)},
+			{fyne.NewPos(10, 0), fyne.NewPos(16, 0)},
+		}))
+		Expect(phase).To(BeZero())
+
+		By("carrying the pattern across segments")
+		dashes = sknlinechart.DashSegments(fyne.NewPos(0, 0), fyne.NewPos(0, 3), []float32{6, 4}, &phase)
+		Expect(dashes).To(HaveLen(1))
+		dashes = sknlinechart.DashSegments(fyne.NewPos(0, 3), fyne.NewPos(0, 8), []float32{6, 4}, &phase)
+		Expect(dashes).To(Equal([][2]fyne.Position{{fyne.NewPos(0, 3), fyne.NewPos(0, 6)}}))
+
+		By("ending on a dash boundary of a segment an exact multiple of the pattern")
+		phase = 0
+		dashes = sknlinechart.DashSegments(fyne.NewPos(0, 0), fyne.NewPos(3, 0), []float32{0.1, 0.2}, &phase)
+		Expect(dashes).To(HaveLen(10))
+	})
+
+	It("should draw thresholds and zones behind the series", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 2)
+		renderer := test.WidgetRenderer(lc.(*sknlinechart.LineChartSkn))
+		before := len(renderer.Objects())
+
+		lc.AddThreshold(60, "High Alarm", theme.ErrorColor(), sknlinechart.RightAxis)
+		lc.AddZone(30, 50, theme.SuccessColor(), "Normal")
+		Expect(renderer.Objects()).To(HaveLen(before + 4))
+
+		By("Keeping cleared references hidden for reuse")
+		lc.ClearThresholds()
+		lc.ClearZones()
+		hidden := 0
+		for _, o := range renderer.Objects() {
+			if !o.Visible() {
+				hidden++
+			}
+		}
+		Expect(hidden).To(BeNumerically(">=", 4))
+	})
+
+	It("should draw thresholds and zones without a color in the foreground color", func() {
+		lc, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithThreshold(60, "High Alarm", nil, sknlinechart.RightAxis),
+			sknlinechart.WithZone(30, 50, nil, "Normal")))
+		Expect(err).NotTo(HaveOccurred())
+		lc.AddThreshold(70, "Trip", nil, sknlinechart.LeftAxis)
+		lc.AddZone(10, 20, nil, "Low")
+		thresholds, zones := sknlinechart.ReferenceColors(lc)
+		Expect(thresholds).To(Equal([]color.Color{theme.ForegroundColor(), theme.ForegroundColor()}))
+		Expect(zones).To(Equal([]color.Color{theme.ForegroundColor(), theme.ForegroundColor()}))
+	})
+
+	It("should anchor event markers by time to datapoints in any readable timestamp format", func() {
+		lc := renderedUI()
+		start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		for idx, layout := range []string{time.RFC3339Nano, time.DateTime, time.RFC1123Z} {
+			point := sknlinechart.NewChartDatapoint(10, theme.ColorBlue, start.Add(time.Duration(idx)*time.Minute).Format(layout))
+			lc.ApplyDataPoint("Testing", &point)
+		}
+		Expect(lc.AddEventMarker("Testing", start.Add(30*time.Second), "Deploy", nil)).To(Succeed())
+		Expect(lc.AddEventMarker("Testing", start.Add(90*time.Second), "Restart", nil)).To(Succeed())
+		lines, _ := sknlinechart.EventLines(lc)
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[0]).To(BeNumerically("<", lines[1]))
+	})
+
+	It("should anchor event markers and annotations to datapoints as they scroll", func() {
+		start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		stamp := func(minute int) string { return start.Add(time.Duration(minute) * time.Minute).Format(time.RFC1123) }
+		dataPoints := map[string][]*sknlinechart.ChartDatapoint{}
+		for x := 0; x < 150; x++ {
+			point := sknlinechart.NewChartDatapoint(float32(x%50), theme.ColorBlue, stamp(x))
+			dataPoints["Testing"] = append(dataPoints["Testing"], &point)
+		}
+		applied := append([]*sknlinechart.ChartDatapoint{}, dataPoints["Testing"]...)
+		lc, _ := sknlinechart.NewLineChart("Testing", "Through Widget", 10, &dataPoints)
+		lc.Resize(fyne.NewSize(800, 400))
+		lc.Refresh()
+		center := func(idx int) float32 { // of the idx-th point still on the chart
+			shown := applied[len(applied)-len(lc.GetDataSeries("Testing")):]
+			top, bottom := (*shown[idx]).MarkerPosition()
+			return (top.X + bottom.X) / 2
+		}
+
+		Expect(lc.AddEventMarker("Testing", 30, "Restart", nil)).To(Succeed())
+		Expect(lc.AddEventMarker("Testing", start.Add(3*time.Minute+time.Second), "Deploy", theme.ErrorColor())).To(Succeed())
+		Expect(lc.AddEventMarker("Testing", stamp(200), "Later", nil)).To(HaveOccurred())
+		Expect(lc.AddEventMarker("Testing", 3.5, "Restart", nil)).To(HaveOccurred())
+		Expect(lc.AddAnnotation((*dataPoints["Testing"][2]).ExternalID(), "Door open", nil)).To(Succeed())
+		Expect(lc.AddAnnotation("unknown", "Door open", nil)).To(HaveOccurred())
+
+		lines, notes := sknlinechart.EventLines(lc)
+		Expect(lines).To(Equal([]float32{center(30), center(4)}))
+		Expect(notes).To(Equal([]string{"Door open"}))
+
+		By("following their datapoints as the series scrolls")
+		apply := func(minute int) {
+			point := sknlinechart.NewChartDatapoint(10, theme.ColorBlue, stamp(minute))
+			applied = append(applied, &point)
+			lc.ApplyDataPoint("Testing", &point)
+		}
+		apply(150)
+		apply(151)
+		lc.Refresh()
+		Expect(lc.GetDataSeries("Testing")[0].Timestamp()).To(Equal(stamp(1)))
+		lines, notes = sknlinechart.EventLines(lc)
+		Expect(lines).To(Equal([]float32{center(29), center(3)}))
+		Expect(notes).To(Equal([]string{"Door open"}))
+
+		By("dropping them when their datapoints roll off")
+		apply(300)
+		apply(301)
+		lc.Refresh()
+		lines, notes = sknlinechart.EventLines(lc)
+		Expect(lines).To(Equal([]float32{center(27), center(1)}))
+		Expect(notes).To(BeEmpty())
+		for minute := 302; minute < 306; minute++ {
+			apply(minute)
+		}
+		lc.Refresh()
+		lines, _ = sknlinechart.EventLines(lc)
+		Expect(lines).To(Equal([]float32{center(23)}))
+
+		lc.ClearEventMarkers()
+		lines, _ = sknlinechart.EventLines(lc)
+		Expect(lines).To(BeEmpty())
+	})
+
+	It("should raise alert rules with hysteresis as datapoints are applied", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 0)
+		var alerts []string
+		lc.SetOnAlertCallback(func(rule sknlinechart.AlertRule, series string, point sknlinechart.ChartDatapoint) {
+			alerts = append(alerts, fmt.Sprint(rule.Name, ":", point.Value()))
+		})
+		Expect(lc.AddAlertRule(sknlinechart.AlertRule{Name: "missing series"})).To(HaveOccurred())
+		Expect(lc.AddAlertRule(sknlinechart.AlertRule{
+			Name:       "High",
+			Series:     "Alerting",
+			Condition:  sknlinechart.AlertAbove,
+			Value:      80,
+			Hysteresis: 5,
+			ForPoints:  2,
+			Color:      theme.ErrorColor(),
+		})).To(Succeed())
+
+		for _, v := range []float32{70, 85, 70, 85, 90, 95, 78, 85, 74, 81, 82} {
+			point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("Alerting", &point)
+		}
+		Expect(alerts).To(Equal([]string{"High:90", "High:82"}))
+	})
+
+	It("should raise alert rules on whichever of points or duration is reached first", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 0)
+		var alerts []string
+		lc.SetOnAlertCallback(func(rule sknlinechart.AlertRule, series string, point sknlinechart.ChartDatapoint) {
+			alerts = append(alerts, fmt.Sprint(rule.Name, ":", point.Timestamp()))
+		})
+		for _, series := range []string{"Slow", "Fast"} {
+			Expect(lc.AddAlertRule(sknlinechart.AlertRule{Name: series, Series: series, Value: 80, ForPoints: 5, ForDuration: 20 * time.Second})).To(Succeed())
+		}
+
+		By("measuring backfilled points by their own timestamps")
+		start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		for idx := 0; idx < 5; idx++ {
+			slow := sknlinechart.NewChartDatapoint(90, theme.ColorBlue, start.Add(time.Duration(idx)*10*time.Second).Format(time.RFC1123))
+			lc.ApplyDataPoint("Slow", &slow)
+			fast := sknlinechart.NewChartDatapoint(90, theme.ColorBlue, start.Add(time.Duration(idx)*time.Second).Format(time.RFC1123))
+			lc.ApplyDataPoint("Fast", &fast)
+		}
+		Expect(alerts).To(Equal([]string{
+			"Slow:" + start.Add(20*time.Second).Format(time.RFC1123), // duration reached on the third point
+			"Fast:" + start.Add(4*time.Second).Format(time.RFC1123),  // points reached on the fifth
+		}))
+	})
+
+	It("should derive delta, rate and integral series from a counter", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 0)
+		Expect(lc.AddDerivedSeries("Counter", "Counter", sknlinechart.DerivedDelta)).To(HaveOccurred())
+		Expect(lc.AddDerivedSeries("Delta", "Counter", sknlinechart.DerivedDelta)).To(Succeed())
+		Expect(lc.AddDerivedSeries("Rate", "Counter", sknlinechart.DerivedRate)).To(Succeed())
+		Expect(lc.AddDerivedSeries("Area", "Counter", sknlinechart.DerivedIntegral)).To(Succeed())
+		Expect(lc.AddDerivedSeries("Rate", "Counter", sknlinechart.DerivedRate)).To(HaveOccurred())
+
+		start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		for idx, v := range []float32{100, 110, 130} {
+			point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, start.Add(time.Duration(idx)*10*time.Second).Format(time.RFC1123))
+			lc.ApplyDataPoint("Counter", &point)
+		}
+		values := func(series string) []float32 {
+			var vals []float32
+			for _, p := range lc.GetDataSeries(series) {
+				vals = append(vals, p.Value())
+			}
+			return vals
+		}
+		Expect(values("Delta")).To(Equal([]float32{10, 20}))
+		Expect(values("Rate")).To(Equal([]float32{1, 2}))
+		Expect(values("Area")).To(Equal([]float32{0, 1050, 2250}))
+
+		lc.RemoveDerivedSeries("Delta")
+		point := sknlinechart.NewChartDatapoint(140, theme.ColorBlue, start.Add(30*time.Second).Format(time.RFC1123))
+		lc.ApplyDataPoint("Counter", &point)
+		Expect(values("Delta")).To(HaveLen(2))
+		Expect(values("Rate")).To(HaveLen(3))
+
+		By("skipping rates and areas across timestamps that cannot be read")
+		for _, ts := range []string{"not a time", start.Add(40 * time.Second).Format(time.RFC1123)} {
+			point := sknlinechart.NewChartDatapoint(150, theme.ColorBlue, ts)
+			lc.ApplyDataPoint("Counter", &point)
+		}
+		Expect(values("Rate")).To(Equal([]float32{1, 2, 1}))
+		Expect(values("Area")).To(Equal([]float32{0, 1050, 2250, 3600, 3600, 3600}))
+	})
+
+	It("should compute expression series from other series", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 0)
+		Expect(lc.AddExpressionSeries("Broken", "A +", sknlinechart.MatchByIndex)).To(HaveOccurred())
+		Expect(lc.AddExpressionSeries("Broken", "median(A)", sknlinechart.MatchByIndex)).To(HaveOccurred())
+		Expect(lc.AddExpressionSeries("Error", `"Temperature" - "Set point"`, sknlinechart.MatchByTimestamp)).To(Succeed())
+		Expect(lc.AddExpressionSeries("Fahrenheit", "Temperature*9/5+32", sknlinechart.MatchByIndex)).To(Succeed())
+		Expect(lc.AddExpressionSeries("Peak", "max(Temperature, -(2 - abs(-4)) * 10)", sknlinechart.MatchByIndex)).To(Succeed())
+
+		apply := func(series string, v float32, ts string) {
+			point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, ts)
+			lc.ApplyDataPoint(series, &point)
+		}
+		apply("Temperature", 20, "t1")
+		apply("Temperature", 25, "t2")
+		apply("Set point", 22, "t2")
+		apply("Set point", 21, "t1")
+
+		values := func(series string) []float32 {
+			var vals []float32
+			for _, p := range lc.GetDataSeries(series) {
+				vals = append(vals, p.Value())
+			}
+			return vals
+		}
+		Expect(values("Error")).To(Equal([]float32{3, -1}))
+		Expect(values("Fahrenheit")).To(Equal([]float32{68, 77}))
+		Expect(values("Peak")).To(Equal([]float32{20, 25}))
+
+		By("rejecting expressions computed from themselves through other series")
+		Expect(lc.AddExpressionSeries("Kelvin", "Fahrenheit + 200", sknlinechart.MatchByIndex)).To(Succeed())
+		Expect(lc.AddExpressionSeries("Temperature", "Kelvin - 273", sknlinechart.MatchByIndex)).To(HaveOccurred())
+		Expect(lc.AddDerivedSeries("Temperature", "Peak", sknlinechart.DerivedDelta)).To(HaveOccurred())
+	})
+
+	It("should pair the newest values once a source stops reporting", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 0)
+		Expect(lc.AddExpressionSeries("Total", "A + B", sknlinechart.MatchByIndex)).To(Succeed())
+		apply := func(series string, v float32) {
+			point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint(series, &point)
+		}
+		for v := 1; v <= 200; v++ {
+			apply("A", float32(v))
+		}
+		apply("B", 1000)
+		apply("B", 1000)
+		var totals []float32
+		for _, p := range lc.GetDataSeries("Total") {
+			totals = append(totals, p.Value())
+		}
+		Expect(totals).To(Equal([]float32{1051, 1052}))
+	})
+
+	It("should smooth a series into a linked companion series", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 0)
+		ema, _ := sknlinechart.NewExponentialAverage("Smooth", 0.5)
+		Expect(lc.SetSeriesSmoothing("Raw", sknlinechart.NewRollingMedian("", 3), ema)).To(Succeed())
+
+		for _, v := range []float32{10, 20, 30} {
+			point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint("Raw", &point)
+		}
+		Expect(lc.GetDataSeries("Raw")).To(HaveLen(3))
+		var smoothed []float32
+		for _, p := range lc.GetDataSeries("Smooth") {
+			smoothed = append(smoothed, p.Value())
+		}
+		Expect(smoothed).To(Equal([]float32{10, 12.5, 16.25}))
+
+		By("stopping smoothing when no smoothers are given")
+		Expect(lc.SetSeriesSmoothing("Raw")).To(Succeed())
+		point := sknlinechart.NewChartDatapoint(40, theme.ColorBlue, time.Now().Format(time.RFC1123))
+		lc.ApplyDataPoint("Raw", &point)
+		Expect(lc.GetDataSeries("Smooth")).To(HaveLen(3))
+
+		By("naming the companion after the series when the smoother is unnamed")
+		Expect(lc.SetSeriesSmoothing("Raw", sknlinechart.NewRollingMedian("", 3))).To(Succeed())
+		lc.ApplyDataPoint("Raw", &point)
+		Expect(lc.GetDataSeries("Raw Smoothed")).To(HaveLen(1))
+
+		By("rejecting a companion name already derived, keeping the earlier smoothing")
+		Expect(lc.AddDerivedSeries("Delta", "Raw", sknlinechart.DerivedDelta)).To(Succeed())
+		Expect(lc.SetSeriesSmoothing("Raw", sknlinechart.NewRollingMedian("Delta", 3))).NotTo(Succeed())
+		lc.ApplyDataPoint("Raw", &point)
+		Expect(lc.GetDataSeries("Raw Smoothed")).To(HaveLen(2))
+		_, err := sknlinechart.NewWithOptions(sknlinechart.NewChartOptions(
+			sknlinechart.WithSeriesSmoothing("A", sknlinechart.NewRollingMedian("Median", 3)),
+			sknlinechart.WithSeriesSmoothing("B", sknlinechart.NewRollingMedian("Median", 3))))
+		Expect(err).To(MatchError(ContainSubstring("already exists")))
+	})
+
+	It("should time smoothing by the datapoint timestamps", func() {
+		lc, _ := makeUI("Testing", "Through Widget", 0)
+		ema, err := sknlinechart.NewTimeExponentialAverage("Smooth", time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(lc.SetSeriesSmoothing("Raw", ema)).To(Succeed())
+
+		start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		for idx, v := range []float32{0, 100, 100, 100} {
+			point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, start.Add(time.Duration(idx)*time.Minute).Format(time.RFC1123))
+			lc.ApplyDataPoint("Raw", &point)
+		}
+		var smoothed []float32
+		for _, p := range lc.GetDataSeries("Smooth") {
+			smoothed = append(smoothed, p.Value())
+		}
+		Expect(smoothed).To(Equal([]float32{0, 50, 75, 87.5}))
+	})
+
+	It("should place smoothed legend entries after their series", func() {
+		apply := func(lc sknlinechart.LineChart, series string) {
+			point := sknlinechart.NewChartDatapoint(10, "", time.Now().Format(time.RFC1123))
+			lc.ApplyDataPoint(series, &point)
+		}
+		for i := 0; i < 10; i++ {
+			lc, _ := makeUI("Testing", "Through Widget", 2)
+			Expect(lc.SetSeriesSmoothing("Raw", sknlinechart.NewRollingMedian("Smooth", 3))).To(Succeed())
+			Expect(lc.SetSeriesSmoothing("Other", sknlinechart.NewRollingMedian("Other Median", 3))).To(Succeed())
+			apply(lc, "Raw")
+			apply(lc, "Other")
+			sknlinechart.ColorLegend(lc) // lays out the series in map order
+			Expect(sknlinechart.LegendSeries(lc)).To(HaveLen(5))
+			names := strings.Join(sknlinechart.LegendSeries(lc), ",")
+			Expect(names).To(ContainSubstring("Raw,Smooth"))
+			Expect(names).To(ContainSubstring("Other,Other Median"))
+		}
+
+		By("moving a companion drawn before its series behind it")
+		lc, _ := makeUI("Testing", "Through Widget", 2)
+		Expect(lc.SetSeriesSmoothing("Raw", sknlinechart.NewRollingMedian("Smooth", 3))).To(Succeed())
+		sknlinechart.ColorLegend(lc)
+		apply(lc, "Smooth")
+		apply(lc, "Later")
+		Expect(sknlinechart.LegendSeries(lc)).To(Equal([]string{"Testing", "Smooth", "Later"}))
+		apply(lc, "Raw")
+		Expect(sknlinechart.LegendSeries(lc)).To(Equal([]string{"Testing", "Later", "Raw", "Smooth"}))
+	})
+
 	It("chart border labels can be changed", func() {
 		lc, _ := makeUI("Testing", "Through Widget", 2)
 
@@ -84,8 +610,75 @@ var _ = Describe("verify line chart initial state", func() {
 		Expect(lc.GetBottomRightLabel()).NotTo(Equal(oldValue))
 	})
 
+	It("should report tapped datapoints and selected ranges", func() {
+		var dataPoints = map[string][]*sknlinechart.ChartDatapoint{}
+		for x := 0; x < 3; x++ {
+			point := sknlinechart.NewChartDatapoint(float32(x*10), theme.ColorBlue, time.Now().Format(time.RFC1123))
+			top := fyne.NewPos(float32(x*10)+8, 48)
+			bottom := fyne.NewPos(float32(x*10)+12, 52)
+			point.SetMarkerPosition(&top, &bottom)
+			dataPoints["Testing"] = append(dataPoints["Testing"], &point)
+		}
+		lc, err := sknlinechart.NewLineChart("Testing", "Interaction", 10, &dataPoints)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("firing the point tapped callback for a datapoint under the pointer")
+		var tappedSeries string
+		var tappedPoint sknlinechart.ChartDatapoint
+		lc.SetOnPointTappedCallback(func(series string, dataPoint sknlinechart.ChartDatapoint) {
+			tappedSeries = series
+			tappedPoint = dataPoint
+		})
+		lc.(fyne.Tappable).Tapped(&fyne.PointEvent{Position: fyne.NewPos(20, 50)})
+		Expect(tappedSeries).To(Equal("Testing"))
+		Expect(tappedPoint.Value()).To(BeNumerically("==", float32(10)))
+
+		By("firing the range selected callback when a drag ends")
+		var start, end int
+		var selected map[string][]sknlinechart.ChartDatapoint
+		lc.SetOnRangeSelectedCallback(func(s, e int, pointsBySeries map[string][]sknlinechart.ChartDatapoint) {
+			start, end, selected = s, e, pointsBySeries
+		})
+		lc.SetRangeSelection(true)
+		lc.(fyne.Draggable).Dragged(&fyne.DragEvent{
+			PointEvent: fyne.PointEvent{Position: fyne.NewPos(25, 50)},
+			Dragged:    fyne.NewDelta(10, 0),
+		})
+		lc.(fyne.Draggable).Dragged(&fyne.DragEvent{
+			PointEvent: fyne.PointEvent{Position: fyne.NewPos(35, 50)},
+			Dragged:    fyne.NewDelta(10, 0),
+		})
+		lc.(fyne.Draggable).DragEnd()
+		Expect(start).To(Equal(1))
+		Expect(end).To(Equal(2))
+		Expect(selected["Testing"]).To(HaveLen(2))
+
+		By("ignoring datapoints of hidden series")
+		lc.SetSeriesVisible("Testing", false)
+		Expect(lc.IsSeriesVisible("Testing")).To(BeFalse())
+		tappedSeries = ""
+		lc.(fyne.Tappable).Tapped(&fyne.PointEvent{Position: fyne.NewPos(20, 50)})
+		Expect(tappedSeries).To(BeEmpty())
+		lc.SetSeriesVisible("Testing", true)
+		Expect(lc.IsSeriesVisible("Testing")).To(BeTrue())
+	})
 })
 
+// hoverOver moves the mouse over the datapoint's marker, returning the hover popup text
+func hoverOver(lc sknlinechart.LineChart, point sknlinechart.ChartDatapoint) string {
+	top, bottom := point.MarkerPosition()
+	lc.(desktop.Hoverable).MouseMoved(&desktop.MouseEvent{PointEvent: fyne.PointEvent{Position: fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)}})
+	return sknlinechart.HoverText(lc)
+}
+
+// renderedUI chart rendered at a fixed size, so applied datapoints are laid out as they arrive
+func renderedUI() sknlinechart.LineChart {
+	lc, _ := makeUI("Testing", "Through Widget", 0)
+	lc.Resize(fyne.NewSize(800, 400))
+	sknlinechart.ColorLegend(lc)
+	return lc
+}
+
 func makeUI(title, footer string, points int) (sknlinechart.LineChart, error) {
 	var dataPoints = map[string][]*sknlinechart.ChartDatapoint{} // legend, points
 	if points != 0 {
@@ -1,11 +1,19 @@
 package sknlinechart
 
-import "fyne.io/fyne/v2"
+import (
+	"fyne.io/fyne/v2"
+	"image/color"
+	"text/template"
+)
 
 // GraphPointSmoothing support for different implementation
 // of averaging or smooth data; current provides rolling average from last x reading.
+// Implementations are safe for concurrent use.
 type GraphPointSmoothing interface {
 	AddValue(value float64) float64
+	Current() float64 // last smoothed value, zero before the first value
+	Window() int      // values considered, zero when every value contributes
+	Reset()           // forgets every value added
 	SeriesName() string
 	IsNil() bool
 	String() string
@@ -16,15 +24,36 @@ type ChartDatapoint interface {
 	Value() float32
 	SetValue(y float32)
 
+	// ColorName fyne primary color name or hex string like #ff8800
 	ColorName() string
 	SetColorName(n string)
 
+	// Color any color, takes precedence over ColorName when set
+	Color() color.Color
+	SetColor(c color.Color)
+
 	Timestamp() string
 	SetTimestamp(t string)
 
 	// ExternalID string uuid assigned when created
 	ExternalID() string
 
+	// Band low and high bounds around the value, false for single value datapoints
+	Band() (low, high float32, ok bool)
+	SetBand(low, high float32)
+
+	// OHLC candlestick open, high, low and close (the value), false for other datapoints
+	OHLC() (open, high, low, close float32, ok bool)
+
+	// Uncertainty below and above the value drawn as an error bar, equal for symmetric
+	// uncertainty; false when none was set
+	Uncertainty() (minus, plus float32, ok bool)
+	SetUncertainty(minus, plus float32)
+
+	// Metadata custom key/value fields available to hover templates
+	Metadata() map[string]string
+	SetMetadata(key, value string)
+
 	// Copy returns a cloned copy of current item
 	Copy() ChartDatapoint
 
@@ -35,6 +64,36 @@ type ChartDatapoint interface {
 	SetMarkerPosition(top *fyne.Position, bottom *fyne.Position)
 }
 
+// LegendPosition where the color legend is placed on the chart
+type LegendPosition int
+
+const (
+	LegendBottom  LegendPosition = iota // bottom right, beside the x scale labels
+	LegendTop                           // top right, above the plot
+	LegendLeft                          // left of the plot, always vertical
+	LegendRight                         // right of the plot, always vertical
+	LegendOverlay                       // inside the plot's top right corner
+)
+
+// HoverFormatter composes the hover popup text for the datapoint under the mouse
+type HoverFormatter func(series string, index int, dataPoint ChartDatapoint) string
+
+// HoverData is the value handed to a hover template
+type HoverData struct {
+	Series    string
+	Index     int
+	Value     float32
+	Open      float32 // candlestick open, equal to Value for other datapoints
+	Low       float32 // band bounds, equal to Value for single value datapoints
+	High      float32
+	Minus     float32 // uncertainty below and above Value, zero when none
+	Plus      float32
+	Timestamp string
+	ColorName string
+	Metadata  map[string]string
+	Point     ChartDatapoint
+}
+
 // LineChart feature list
 type LineChart interface {
 	// Chart Attributes
@@ -42,6 +101,11 @@ type LineChart interface {
 	GetLineStrokeSize() float32
 	SetLineStrokeSize(newSize float32)
 
+	// Series style: color, stroke width, dash pattern, marker shape/size and opacity
+
+	GetSeriesStyle(series string) SeriesStyle
+	SetSeriesStyle(series string, style SeriesStyle) error
+
 	IsDataPointMarkersEnabled() bool // mouse button 2 toggles
 	IsHorizGridLinesEnabled() bool
 	IsVertGridLinesEnabled() bool
@@ -54,6 +118,59 @@ type LineChart interface {
 	SetColorLegend(enable bool)
 	SetMousePointDisplay(enable bool)
 
+	// Color legend placement, orientation and latest series value display
+
+	SetLegendPosition(position LegendPosition) error
+	SetLegendVertical(enable bool)
+	SetLegendLastValue(enable bool)
+	SetSeriesUnit(series, unit string)
+
+	// Threshold lines and shaded zones drawn behind the series
+
+	AddThreshold(value float32, label string, c color.Color, axis ScaleAxis)
+	AddZone(min, max float32, c color.Color, label string)
+	ClearThresholds()
+	ClearZones()
+
+	// Event markers and annotations anchored to datapoints, scrolling with the data
+
+	AddEventMarker(series string, at any, label string, c color.Color) error
+	AddAnnotation(externalID, text string, c color.Color) error
+	ClearEventMarkers()
+	ClearAnnotations()
+
+	// Derived series computed from datapoints applied to their sources
+
+	AddDerivedSeries(name, source string, kind DerivedKind) error
+	AddExpressionSeries(name, expression string, match SeriesMatch) error
+	SetSeriesSmoothing(series string, smoothers ...GraphPointSmoothing) error
+	RemoveDerivedSeries(name string)
+
+	// Alert rules evaluated as datapoints are applied
+
+	AddAlertRule(rule AlertRule) error
+	ClearAlertRules()
+
+	// XY plot of one series' values against another's, nil returns to the time series
+
+	GetXYPlot() *XYPlot
+	SetXYPlot(plot *XYPlot)
+
+	// Series visibility, tapping a color legend entry toggles
+
+	IsSeriesVisible(series string) bool
+	SetSeriesVisible(series string, visible bool)
+
+	// Y scale, keyboard +/- halves or doubles the factor
+
+	GetYScaleFactor() int
+	SetYScaleFactor(yScaleFactor int)
+
+	// Keyboard cursor tracks newest point, space bar toggles
+
+	IsLiveFollowEnabled() bool
+	SetLiveFollow(enable bool)
+
 	// Scale legend
 
 	GetMiddleLeftLabel() string
@@ -85,6 +202,9 @@ type LineChart interface {
 	// If series has more than 130 points, point 0 will be rolled out making room for this one
 	ApplyDataPoint(seriesName string, newDataPoint *ChartDatapoint)
 
+	// GetDataSeries copies of the series' current datapoints, oldest first
+	GetDataSeries(seriesName string) []ChartDatapoint
+
 	// SetMinSize set the minimum size limit for the linechart
 	SetMinSize(s fyne.Size)
 
@@ -94,6 +214,32 @@ type LineChart interface {
 	// SetHoverPointCallback method to call when a onscreen datapoint is hovered over by pointer
 	SetOnHoverPointCallback(func(series string, dataPoint ChartDatapoint))
 
+	// SetOnPointTappedCallback method to call when a onscreen datapoint is tapped with mouse button one
+	SetOnPointTappedCallback(func(series string, dataPoint ChartDatapoint))
+
+	// SetOnAlertCallback method to call when an alert rule is raised by an applied datapoint
+	SetOnAlertCallback(func(rule AlertRule, series string, dataPoint ChartDatapoint))
+
+	// SetOnRangeSelectedCallback method to call when a drag selection of datapoints completes
+	// start and end are the first and last index selected, pointsBySeries holds copies of the selected points
+	SetOnRangeSelectedCallback(func(start, end int, pointsBySeries map[string][]ChartDatapoint))
+
+	// IsRangeSelectionEnabled returns state of drag to select ranges
+	IsRangeSelectionEnabled() bool
+
+	// SetRangeSelection enables drag to select a range of datapoints; the chart
+	// takes drags whether or not selection is enabled
+	SetRangeSelection(enable bool)
+
+	// ClearRangeSelection removes the shaded selection from the chart
+	ClearRangeSelection()
+
+	// SetHoverFormatter replaces the default hover popup text; nil restores the default
+	SetHoverFormatter(f HoverFormatter)
+
+	// SetHoverTemplate renders the hover popup text from a template given HoverData; nil restores the default
+	SetHoverTemplate(t *template.Template)
+
 	// ObjectCount internal use only: return the default ui elements for testing
 	ObjectCount() int
 
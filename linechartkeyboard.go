@@ -0,0 +1,199 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"sort"
+	"strings"
+	"time"
+)
+
+var _ fyne.Focusable = (*LineChartSkn)(nil)
+
+// zoomStep y scale factor before and after zooming in, so zooming out returns exactly
+type zoomStep struct {
+	from int
+	to   int
+}
+
+// FocusGained From the Focusable Interface, places the cursor on the selected series
+func (w *LineChartSkn) FocusGained() {
+	w.debugLog("LineChartSkn::FocusGained()")
+	w.mapsLock.Lock()
+	w.hasFocus = true
+	if len(w.dataPoints[w.cursorSeries]) == 0 {
+		names := w.sortedSeriesNames()
+		if len(names) > 0 {
+			w.cursorSeries = names[0]
+		}
+		w.cursorIndex = len(w.dataPoints[w.cursorSeries]) - 1
+	}
+	w.mapsLock.Unlock()
+	w.showCursor()
+}
+
+// FocusLost From the Focusable Interface, hides the cursor popup
+func (w *LineChartSkn) FocusLost() {
+	w.debugLog("LineChartSkn::FocusLost()")
+	w.mapsLock.Lock()
+	w.hasFocus = false
+	w.mapsLock.Unlock()
+	w.disableMouseContainer()
+}
+
+// TypedRune From the Focusable Interface, +/= zooms in and - zooms out,
+// zooming out steps back through the scales zoomed in from
+func (w *LineChartSkn) TypedRune(r rune) {
+	w.debugLog("LineChartSkn::TypedRune() ", string(r))
+	w.mapsLock.Lock()
+	current := w.chartScaleMultiplier
+	next := current
+	switch r {
+	case '+', '=':
+		if current > 1 {
+			next = current / 2
+			w.zoomHistory = append(w.zoomHistory, zoomStep{from: current, to: next})
+		}
+	case '-':
+		last := len(w.zoomHistory) - 1
+		if last >= 0 && w.zoomHistory[last].to == current {
+			next = w.zoomHistory[last].from
+			w.zoomHistory = w.zoomHistory[:last]
+		} else {
+			next = current * 2
+			w.zoomHistory = nil // scale was changed elsewhere
+		}
+	default:
+		w.mapsLock.Unlock()
+		return
+	}
+	w.mapsLock.Unlock()
+	w.SetYScaleFactor(next)
+	w.Refresh()
+	w.showCursor()
+}
+
+// TypedKey From the Focusable Interface, steps the cursor through the chart's datapoints
+//
+//	Left/Right move point by point, Up/Down switch series, Home/End jump to oldest/newest
+//	and Space toggles live follow of the newest point
+func (w *LineChartSkn) TypedKey(ke *fyne.KeyEvent) {
+	startTime := time.Now()
+	w.debugLog("LineChartSkn::TypedKey() ENTER ", ke.Name)
+
+	w.mapsLock.Lock()
+	names := w.sortedSeriesNames()
+	last := len(w.dataPoints[w.cursorSeries]) - 1
+	switch ke.Name {
+	case fyne.KeyLeft:
+		w.liveFollow = false
+		if w.cursorIndex > 0 {
+			w.cursorIndex--
+		}
+	case fyne.KeyRight:
+		if w.cursorIndex < last {
+			w.cursorIndex++
+		}
+	case fyne.KeyHome:
+		w.liveFollow = false
+		w.cursorIndex = 0
+	case fyne.KeyEnd:
+		w.cursorIndex = last
+	case fyne.KeyUp, fyne.KeyDown:
+		w.cursorSeries = stepSeries(names, w.cursorSeries, ke.Name == fyne.KeyDown)
+		last = len(w.dataPoints[w.cursorSeries]) - 1
+		if w.cursorIndex > last || w.liveFollow {
+			w.cursorIndex = last
+		}
+	case fyne.KeySpace:
+		w.liveFollow = !w.liveFollow
+		if w.liveFollow {
+			w.cursorIndex = last
+		}
+	default:
+		w.mapsLock.Unlock()
+		return
+	}
+	w.mapsLock.Unlock()
+
+	w.showCursor()
+	w.debugLog("LineChartSkn::TypedKey() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
+}
+
+// IsLiveFollowEnabled returns state of the keyboard cursor tracking the newest point
+func (w *LineChartSkn) IsLiveFollowEnabled() bool {
+	w.mapsLock.RLock()
+	defer w.mapsLock.RUnlock()
+	return w.liveFollow
+}
+
+// SetLiveFollow keyboard cursor tracks the newest point as they arrive, space bar toggles
+func (w *LineChartSkn) SetLiveFollow(enable bool) {
+	w.mapsLock.Lock()
+	w.liveFollow = enable
+	w.mapsLock.Unlock()
+}
+
+// followCursor moves the cursor to the newest point when live follow is on
+func (w *LineChartSkn) followCursor(seriesName string) {
+	w.mapsLock.Lock()
+	if !w.hasFocus || !w.liveFollow || seriesName != w.cursorSeries {
+		w.mapsLock.Unlock()
+		return
+	}
+	w.cursorIndex = len(w.dataPoints[w.cursorSeries]) - 1
+	w.mapsLock.Unlock()
+	w.showCursor()
+}
+
+// showCursor displays the hover popup over the cursor's datapoint
+func (w *LineChartSkn) showCursor() {
+	w.mapsLock.RLock()
+	series, idx := strings.Clone(w.cursorSeries), w.cursorIndex
+	points := w.dataPoints[series]
+	if idx < 0 || idx >= len(points) {
+		w.mapsLock.RUnlock()
+		return
+	}
+	top, bottom := (*points[idx]).MarkerPosition()
+	pos := fyne.NewPos((top.X+bottom.X)/2, (top.Y+bottom.Y)/2)
+	point := (*points[idx]).Copy()
+	w.mapsLock.RUnlock()
+	w.showHover(series, idx, point, pos)
+}
+
+// requestFocus asks the canvas holding this chart to focus it for keyboard input
+func (w *LineChartSkn) requestFocus() {
+	if fyne.CurrentApp() == nil {
+		return
+	}
+	if c := fyne.CurrentApp().Driver().CanvasForObject(w); c != nil {
+		c.Focus(w)
+	}
+}
+
+// sortedSeriesNames series names in display order; caller must hold the maps lock
+func (w *LineChartSkn) sortedSeriesNames() []string {
+	names := make([]string, 0, len(w.dataPoints))
+	for key, points := range w.dataPoints {
+		if len(points) > 0 && !w.hiddenSeries[key] {
+			names = append(names, key)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// stepSeries returns the series before or after current, wrapping around
+func stepSeries(names []string, current string, forward bool) string {
+	if len(names) == 0 {
+		return current
+	}
+	idx := sort.SearchStrings(names, current)
+	if idx >= len(names) || names[idx] != current {
+		return names[0]
+	}
+	if forward {
+		return names[(idx+1)%len(names)]
+	}
+	return names[(idx+len(names)-1)%len(names)]
+}
@@ -0,0 +1,54 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Keyboard navigation", func() {
+
+	It("should step the cursor, zoom and toggle live follow", func() {
+		lc, err := makeUI("Testing", "Keyboard", 10)
+		Expect(err).NotTo(HaveOccurred())
+		focusable := lc.(fyne.Focusable)
+
+		var hovered []float32
+		lc.SetOnHoverPointCallback(func(series string, dataPoint sknlinechart.ChartDatapoint) {
+			hovered = append(hovered, dataPoint.Value())
+		})
+
+		By("showing the newest point when focus is gained")
+		focusable.FocusGained()
+		Expect(hovered).To(HaveLen(1))
+
+		By("stepping left disables live follow")
+		Expect(lc.IsLiveFollowEnabled()).To(BeTrue())
+		focusable.TypedKey(&fyne.KeyEvent{Name: fyne.KeyLeft})
+		Expect(lc.IsLiveFollowEnabled()).To(BeFalse())
+		Expect(hovered).To(HaveLen(2))
+
+		By("space toggles live follow back on")
+		focusable.TypedKey(&fyne.KeyEvent{Name: fyne.KeySpace})
+		Expect(lc.IsLiveFollowEnabled()).To(BeTrue())
+		Expect(hovered[2]).To(Equal(hovered[0]))
+
+		By("+ and - change the y scale factor")
+		focusable.TypedRune('-')
+		Expect(lc.GetYScaleFactor()).To(Equal(20))
+		focusable.TypedRune('+')
+		Expect(lc.GetYScaleFactor()).To(Equal(10))
+
+		By("- returning to the scale zoomed in from")
+		lc.SetYScaleFactor(15)
+		focusable.TypedRune('+')
+		focusable.TypedRune('+')
+		Expect(lc.GetYScaleFactor()).To(Equal(3))
+		focusable.TypedRune('-')
+		focusable.TypedRune('-')
+		Expect(lc.GetYScaleFactor()).To(Equal(15))
+		focusable.TypedRune('-')
+		Expect(lc.GetYScaleFactor()).To(Equal(30))
+	})
+})
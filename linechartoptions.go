@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/theme"
+	"image/color"
 	"log"
 	"os"
 	"sync"
+	"text/template"
 )
 
 // ChartOption alternate methodof sett chart properties
@@ -73,9 +75,17 @@ func NewWithOptions(options *ChartOptions) (LineChart, error) {
 		enableVertGridLines:     true,
 		enableMousePointDisplay: true,
 		enableColorLegend:       true,
+		liveFollow:              true,
+		hiddenSeries:            map[string]bool{},
+		seriesUnits:             map[string]string{},
+		seriesStyles:            map[string]SeriesStyle{},
+		colorPalette:            ColorBlindSafePalette,
+		seriesPaletteColors:     map[string]color.Color{},
+		alertColors:             map[string]color.Color{},
+		linkedSeries:            map[string]string{},
 		mouseDisplayStr:         "",
 		mouseDisplayPosition:    &fyne.Position{},
-		mouseDisplayFrameColor:  string(theme.ColorNameForeground),
+		mouseDisplayFrameColor:  theme.ForegroundColor(),
 		topLeftLabel:            "",
 		topCenteredLabel:        "",
 		topRightLabel:           "",
@@ -92,6 +102,7 @@ func NewWithOptions(options *ChartOptions) (LineChart, error) {
 
 	err := options.Apply(w)
 
+	w.assignPaletteColors()
 	w.ExtendBaseWidget(w) // Initialize the BaseWidget
 	return w, err
 }
@@ -171,6 +182,14 @@ func WithYScaleFactor(maxYScaleLabel int) ChartOption {
 	}
 }
 
+// WithLiveFollow keyboard cursor tracks the newest point as they arrive, space bar toggles
+func WithLiveFollow(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.liveFollow = enable
+		return nil
+	}
+}
+
 // WithMinSize sets the minimum x/y size of chart
 func WithMinSize(width, height float32) ChartOption {
 	return func(lc *LineChartSkn) error {
@@ -219,6 +238,97 @@ func WithColorLegend(enable bool) ChartOption {
 	}
 }
 
+// WithLegendPosition places the color legend top, bottom, left, right, or overlaid on the plot
+func WithLegendPosition(position LegendPosition) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if err := position.validate(); err != nil {
+			return err
+		}
+		lc.legendPosition = position
+		return nil
+	}
+}
+
+// WithLegendVertical stacks the color legend entries vertically
+func WithLegendVertical(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.legendVertical = enable
+		return nil
+	}
+}
+
+// WithLegendLastValue shows each series' latest value in its legend entry
+func WithLegendLastValue(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableLegendLastValue = enable
+		return nil
+	}
+}
+
+// WithSeriesUnit sets the unit shown after the series' latest value in the legend
+func WithSeriesUnit(series, unit string) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.seriesUnits[series] = unit
+		return nil
+	}
+}
+
+// WithSeriesStyle sets color, stroke width, dash pattern, marker shape/size and opacity of a series
+func WithSeriesStyle(series string, style SeriesStyle) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if err := style.validate(series); err != nil {
+			return err
+		}
+		lc.seriesStyles[series] = style
+		return nil
+	}
+}
+
+// WithThreshold draws a labeled horizontal reference line at the value, a nil color uses the foreground color
+func WithThreshold(value float32, label string, c color.Color, axis ScaleAxis) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if c == nil {
+			c = theme.ForegroundColor()
+		}
+		lc.thresholds = append(lc.thresholds, threshold{value: value, label: label, color: c, axis: axis})
+		return nil
+	}
+}
+
+// WithZone shades the band between min and max, opaque colors are made translucent
+// and a nil color uses the foreground color
+func WithZone(min, max float32, c color.Color, label string) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if c == nil {
+			c = theme.ForegroundColor()
+		}
+		lc.zones = append(lc.zones, zone{min: min, max: max, color: c, label: label})
+		return nil
+	}
+}
+
+// WithXYPlot shows the values of one series plotted against another's in place of the time series
+func WithXYPlot(plot XYPlot) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if plot.XSeries == "" || plot.YSeries == "" {
+			return errors.New("xy plot requires both an x and a y series")
+		}
+		lc.xyPlot = &plot
+		return nil
+	}
+}
+
+// WithColorPalette colors assigned in order to series whose points and style give no usable color
+func WithColorPalette(palette []color.Color) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if len(palette) == 0 {
+			return errors.New("color palette cannot be empty")
+		}
+		lc.colorPalette = palette
+		return nil
+	}
+}
+
 // WithDebugLogging activate logger to record method entry/exits
 func WithDebugLogging(enable bool) ChartOption {
 	return func(lc *LineChartSkn) error {
@@ -235,6 +345,89 @@ func WithOnHoverPointCallback(callBack func(series string, dataPoint ChartDatapo
 	}
 }
 
+// WithOnPointTappedCallback set callback function for datapoint tapped with mouse button one
+func WithOnPointTappedCallback(callBack func(series string, dataPoint ChartDatapoint)) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.OnPointTappedCallback = callBack
+		return nil
+	}
+}
+
+// WithOnAlertCallback set callback function for alert rules being raised
+func WithOnAlertCallback(callBack func(rule AlertRule, series string, dataPoint ChartDatapoint)) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.OnAlertCallback = callBack
+		return nil
+	}
+}
+
+// WithDerivedSeries adds a delta, rate or integral series of the source series
+func WithDerivedSeries(name, source string, kind DerivedKind) ChartOption {
+	return func(lc *LineChartSkn) error {
+		return lc.AddDerivedSeries(name, source, kind)
+	}
+}
+
+// WithExpressionSeries adds a series computed from an expression over other series
+func WithExpressionSeries(name, expression string, match SeriesMatch) ChartOption {
+	return func(lc *LineChartSkn) error {
+		return lc.AddExpressionSeries(name, expression, match)
+	}
+}
+
+// WithSeriesSmoothing smooths datapoints applied to the series into a linked companion series
+func WithSeriesSmoothing(series string, smoothers ...GraphPointSmoothing) ChartOption {
+	return func(lc *LineChartSkn) error {
+		return lc.SetSeriesSmoothing(series, smoothers...)
+	}
+}
+
+// WithAlertRule evaluates the rule against each datapoint applied to its series
+func WithAlertRule(rule AlertRule) ChartOption {
+	return func(lc *LineChartSkn) error {
+		return lc.AddAlertRule(rule)
+	}
+}
+
+// WithRangeSelection enables drag to select a range of datapoints
+func WithRangeSelection(enable bool) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.enableRangeSelection = enable
+		return nil
+	}
+}
+
+// WithOnRangeSelectedCallback set callback function for a completed drag selection, also enables range selection
+func WithOnRangeSelectedCallback(callBack func(start, end int, pointsBySeries map[string][]ChartDatapoint)) ChartOption {
+	return func(lc *LineChartSkn) error {
+		lc.OnRangeSelectedCallback = callBack
+		lc.enableRangeSelection = true
+		return nil
+	}
+}
+
+// WithHoverFormatter set function composing the hover popup text
+func WithHoverFormatter(formatter HoverFormatter) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if formatter == nil {
+			return errors.New("hover formatter cannot be nil")
+		}
+		lc.hoverFormatter = formatter
+		return nil
+	}
+}
+
+// WithHoverTemplate set template composing the hover popup text, executed with HoverData
+func WithHoverTemplate(tmpl *template.Template) ChartOption {
+	return func(lc *LineChartSkn) error {
+		if tmpl == nil {
+			return errors.New("hover template cannot be nil")
+		}
+		lc.hoverTemplate = tmpl
+		return nil
+	}
+}
+
 // WithDataPoints Primary series data to initialize chart with
 func WithDataPoints(seriesData map[string][]*ChartDatapoint) ChartOption {
 	return func(lc *LineChartSkn) error {
@@ -1,11 +1,14 @@
 package sknlinechart
 
 import (
+	"fmt"
 	"fyne.io/fyne/v2"
 	"fyne.io/fyne/v2/canvas"
 	"fyne.io/fyne/v2/container"
+	"fyne.io/fyne/v2/layout"
 	"fyne.io/fyne/v2/theme"
 	"fyne.io/fyne/v2/widget"
+	"image/color"
 	"math"
 	"strconv"
 	"strings"
@@ -17,8 +20,23 @@ type lineChartRenderer struct {
 	widget                *LineChartSkn // Reference to the widget holding the current state
 	xInc                  float32
 	yInc                  float32
+	xOffset               float32 // room reserved on the left for the color legend
+	legendReserved        float32 // width reserved for a left or right color legend
 	dataPoints            map[string][]*canvas.Line
-	dataPointMarkers      map[string][]*canvas.Circle
+	dataPointMarkers      map[string][]fyne.CanvasObject
+	segmentLines          map[string][][]*canvas.Line // dashed or multi-part segments, by datapoint
+	barRects              map[string][]*canvas.Rectangle
+	errorBars             map[string][]*errorBar
+	xyMarkers             []*canvas.Circle
+	zoneRects             []*canvas.Rectangle
+	zoneLabels            []*canvas.Text
+	thresholdLines        []*canvas.Line
+	thresholdLabels       []*canvas.Text
+	eventLines            []*canvas.Line
+	eventLabels           []*canvas.Text
+	annotationLabels      []*canvas.Text
+	xyRegression          *canvas.Line
+	areaFills             map[string]*areaFill
 	mouseDisplayContainer *fyne.Container
 	xLines                []*canvas.Line
 	yLines                []*canvas.Line
@@ -33,6 +51,8 @@ type lineChartRenderer struct {
 	leftMiddleBox         *fyne.Container
 	rightMiddleBox        *fyne.Container
 	colorLegend           *fyne.Container
+	legendFrame           *canvas.Rectangle
+	selectionBox          *canvas.Rectangle
 }
 
 var _ fyne.WidgetRenderer = (*lineChartRenderer)(nil)
@@ -46,10 +66,11 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 	startTime := time.Now()
 	lineChart.mapsLock.Lock()
 	defer lineChart.mapsLock.Unlock()
+	lineChart.assignPaletteColors()
 
 	var (
 		dataPoints       = map[string][]*canvas.Line{}
-		dpMaker          = map[string][]*canvas.Circle{}
+		dpMaker          = map[string][]fyne.CanvasObject{}
 		objs             []fyne.CanvasObject
 		xlines, ylines   []*canvas.Line
 		xLabels, yLabels []*canvas.Text
@@ -57,7 +78,7 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 
 	// hover frame
 	border := canvas.NewRectangle(theme.OverlayBackgroundColor())
-	border.StrokeColor = theme.PrimaryColorNamed(lineChart.mouseDisplayFrameColor)
+	border.StrokeColor = lineChart.mouseDisplayFrameColor
 	border.StrokeWidth = 2.0
 
 	// hover content
@@ -74,6 +95,17 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 	)
 	mouseDisplay.Hide()
 
+	// legend backdrop when overlaid on the plot
+	legendFrame := canvas.NewRectangle(withAlpha(theme.BackgroundColor(), 0xc0))
+	legendFrame.StrokeWidth = 1
+	legendFrame.Hide()
+
+	// range selection shading
+	selectionBox := canvas.NewRectangle(withAlpha(theme.PrimaryColor(), 0x40))
+	selectionBox.StrokeColor = theme.PrimaryColor()
+	selectionBox.StrokeWidth = 1
+	selectionBox.Hide()
+
 	// x & y frame lines
 	for i := 0; i < 16; i++ { // vertical
 		x := canvas.NewLine(theme.PrimaryColorNamed(theme.ColorGreen))
@@ -107,20 +139,18 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 
 	// series legend on bottom right
 	colorLegend := container.NewHBox()
-	strokeSize := lineChart.dataPointStrokeSize
-	markerSize := strokeSize * 5
 	for key, points := range lineChart.dataPoints {
+		style := lineChart.seriesStyle(key)
 		for _, point := range points {
-			x := canvas.NewLine(theme.PrimaryColorNamed((*point).ColorName()))
-			x.StrokeWidth = strokeSize
+			x := canvas.NewLine(lineChart.seriesColor(key, *point))
+			x.StrokeWidth = style.StrokeWidth
 			dataPoints[key] = append(dataPoints[key], x)
-			z := canvas.NewCircle(theme.PrimaryColorNamed((*point).ColorName()))
-			z.StrokeWidth = strokeSize * 2
-			z.Resize(fyne.NewSize(markerSize, markerSize))
-			dpMaker[key] = append(dpMaker[key], z)
+			dpMaker[key] = append(dpMaker[key], newDataPointMarker(style.MarkerShape, lineChart.seriesColor(key, *point)))
+		}
+		if len(points) > 0 {
+			e := newLegendEntry(key, lineChart.seriesColor(key, *points[0]), lineChart.toggleSeriesVisible, lineChart.highlightSeries)
+			colorLegend.Objects = placeLegendEntry(colorLegend.Objects, e, lineChart.linkedSeries)
 		}
-		z := canvas.NewText(key, theme.PrimaryColorNamed((*points[0]).ColorName()))
-		colorLegend.Add(z)
 	}
 
 	topCenteredDesc := canvas.NewText(lineChart.topCenteredLabel, theme.ForegroundColor())
@@ -187,8 +217,15 @@ func newLineChartRenderer(lineChart *LineChartSkn) fyne.WidgetRenderer {
 		leftMiddleBox:         lBox,
 		rightMiddleBox:        rBox,
 		dataPointMarkers:      dpMaker,
+		segmentLines:          map[string][][]*canvas.Line{},
+		barRects:              map[string][]*canvas.Rectangle{},
+		errorBars:             map[string][]*errorBar{},
+		xyRegression:          canvas.NewLine(theme.ForegroundColor()),
+		areaFills:             map[string]*areaFill{},
 		mouseDisplayContainer: mouseDisplay,
 		colorLegend:           colorLegend,
+		legendFrame:           legendFrame,
+		selectionBox:          selectionBox,
 	}
 }
 
@@ -323,6 +360,9 @@ func (r *lineChartRenderer) Refresh() {
 	r.bottomLeftDesc.Text = r.widget.bottomLeftLabel
 	r.bottomCenteredDesc.Text = r.widget.bottomCenteredLabel
 	r.bottomRightDesc.Text = r.widget.bottomRightLabel
+	for idx, label := range r.yLabels {
+		label.Text = strconv.Itoa((13 - idx) * r.widget.chartScaleMultiplier)
+	}
 	for _, v := range r.widget.objectsCache {
 		v.Refresh()
 	}
@@ -334,7 +374,7 @@ func (r *lineChartRenderer) Refresh() {
 	r.widget.mapsLock.Lock()
 
 	r.mouseDisplayContainer.Hide()
-	r.mouseDisplayContainer.Objects[0].(*canvas.Rectangle).StrokeColor = theme.PrimaryColorNamed(r.widget.mouseDisplayFrameColor)
+	r.mouseDisplayContainer.Objects[0].(*canvas.Rectangle).StrokeColor = r.widget.mouseDisplayFrameColor
 	r.mouseDisplayContainer.Objects[1].(*widget.Label).SetText(r.widget.mouseDisplayStr)
 
 	r.widget.mapsLock.Unlock()
@@ -351,54 +391,201 @@ func (r *lineChartRenderer) Refresh() {
 		r.mouseDisplayContainer.Hide()
 	}
 
+	r.layoutSelection()
+	if width, offset := r.legendReservation(); width != r.legendReserved || offset != r.xOffset { // legend moved or resized
+		r.Layout(r.widget.Size())
+	} else {
+		r.layoutLegend(r.widget.Size())
+	}
+
 	r.widget.debugLog("lineChartRenderer::Refresh() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 }
 
+// legendReservation width of a color legend placed left or right of the plot, and the
+// plot's x offset making room for it; both zero for other placements
+func (r *lineChartRenderer) legendReservation() (float32, float32) {
+	if !r.widget.enableColorLegend {
+		return 0, 0
+	}
+	if r.widget.legendPosition != LegendLeft && r.widget.legendPosition != LegendRight {
+		return 0, 0
+	}
+	var width float32 // widest entry, as the vertical layout of side legends sizes it
+	for _, o := range r.colorLegend.Objects {
+		if o.Visible() && o.MinSize().Width > width {
+			width = o.MinSize().Width
+		}
+	}
+	if width == 0 {
+		return 0, 0
+	}
+	if r.widget.legendPosition == LegendLeft {
+		return width, width + theme.Padding()
+	}
+	return width, 0
+}
+
+// layoutLegend orients and places the color legend per the chart's legend position
+func (r *lineChartRenderer) layoutLegend(s fyne.Size) {
+	vertical := r.widget.legendVertical ||
+		r.widget.legendPosition == LegendLeft || r.widget.legendPosition == LegendRight
+	if vertical {
+		r.colorLegend.Layout = layout.NewVBoxLayout()
+	} else {
+		r.colorLegend.Layout = layout.NewHBoxLayout()
+	}
+	z := r.colorLegend.MinSize()
+	r.colorLegend.Resize(z)
+
+	var pos fyne.Position
+	switch r.widget.legendPosition {
+	case LegendTop:
+		pos = fyne.NewPos(s.Width-(z.Width+theme.Padding()), r.yInc-z.Height)
+		if pos.Y < 0 {
+			pos.Y = 0
+		}
+	case LegendLeft:
+		pos = fyne.NewPos(theme.Padding(), r.yInc)
+		if r.widget.leftMiddleLabel != "" {
+			pos.X += r.leftMiddleBox.Size().Width
+		}
+	case LegendRight:
+		pos = fyne.NewPos(r.xInc*16+r.xOffset+theme.Padding()*2, r.yInc)
+	case LegendOverlay:
+		pos = fyne.NewPos(r.xInc*16+r.xOffset-(z.Width+theme.Padding()), r.yInc+theme.Padding())
+	default: // LegendBottom
+		pos = fyne.NewPos(s.Width-(z.Width+theme.Padding()), (r.yInc*15)+theme.Padding())
+	}
+	r.colorLegend.Move(pos)
+
+	if r.widget.legendPosition == LegendOverlay && r.widget.enableColorLegend {
+		r.legendFrame.FillColor = withAlpha(theme.BackgroundColor(), 0xc0)
+		r.legendFrame.StrokeColor = theme.ForegroundColor()
+		r.legendFrame.Move(fyne.NewPos(pos.X-theme.Padding()/2, pos.Y-theme.Padding()/2))
+		r.legendFrame.Resize(fyne.NewSize(z.Width+theme.Padding(), z.Height+theme.Padding()))
+		r.legendFrame.Show()
+	} else {
+		r.legendFrame.Hide()
+	}
+}
+
+// layoutSelection shades the range being, or last, selected by dragging
+func (r *lineChartRenderer) layoutSelection() {
+	if !r.widget.selectionShown {
+		r.selectionBox.Hide()
+		return
+	}
+	minX, maxX := r.widget.selectionStartX, r.widget.selectionEndX
+	if minX > maxX {
+		minX, maxX = maxX, minX
+	}
+	if minX < r.xInc+r.xOffset {
+		minX = r.xInc + r.xOffset
+	}
+	if maxX > r.xInc*16+r.xOffset {
+		maxX = r.xInc*16 + r.xOffset
+	}
+	r.selectionBox.Move(fyne.NewPos(minX, r.yInc))
+	r.selectionBox.Resize(fyne.NewSize(maxX-minX, r.yInc*13))
+	r.selectionBox.FillColor = withAlpha(theme.PrimaryColor(), 0x40)
+	r.selectionBox.StrokeColor = theme.PrimaryColor()
+	r.selectionBox.Show()
+	r.selectionBox.Refresh()
+}
+
 // layoutSeries layout one series to position new elements
 func (r *lineChartRenderer) layoutSeries(series string) {
 	startTime := time.Now()
 
 	r.widget.debugLog("lineChartRenderer::layoutSeries() ENTER. Series: ", series)
 	// data points
-	xp := r.xInc
+	xp := r.xInc + r.xOffset
 	yp := r.yInc * 14.0
 	yScale := (r.yInc * 10) / (10.0 * float32(r.widget.chartScaleMultiplier)) // 100
 	xScale := (r.xInc * 10) / 100
 	var dp float32
-	data := r.widget.dataPoints[series] // datasource
-	lastPoint := fyne.NewPos(xp, yp)
+	data := r.widget.dataPoints[series]                               // datasource
+	hidden := r.widget.hiddenSeries[series] || r.widget.xyPlot != nil // an XY plot replaces the time series
+	dimmed := !r.widget.isHighlighted(series)
+	style := r.widget.seriesStyle(series)
+	half := style.MarkerSize / 2
+	var phase float32
+	var stackBase []float32
+	if style.Mode == SeriesStackedArea {
+		stackBase = r.stackBase(series)
+	}
+	var upper, lower, bandHigh []fyne.Position
 
-	for idx, point := range data { // one set of lines
-		if (*point).Value() > r.widget.dataPointYLimit { // max y chart scale
-			dp = r.widget.dataPointYLimit
-		} else if (*point).Value() < 0.0 {
-			dp = 0.0
-		} else {
-			dp = (*point).Value()
+	for idx, point := range data { // screen positions
+		var base float32
+		if idx < len(stackBase) {
+			base = stackBase[idx]
 		}
+		dp = r.clampValue((*point).Value() + base)
 		yy := yp - (dp * yScale) // using same datasource value
 		xx := xp + (float32(idx) * xScale)
 
 		xx = float32(math.Trunc(float64(xx)))
 		yy = float32(math.Trunc(float64(yy)))
 
-		thisPoint := fyne.NewPos(xx, yy)
-		if idx == 0 {
-			lastPoint.Y = yy
+		upper = append(upper, fyne.NewPos(xx, yy))
+		if stackBase != nil {
+			lower = append(lower, fyne.NewPos(xx, float32(math.Trunc(float64(yp-(r.clampValue(base)*yScale))))))
+		}
+		if style.Mode == SeriesBand {
+			low, high := bandBounds(*point)
+			lower = append(lower, fyne.NewPos(xx, float32(math.Trunc(float64(yp-(r.clampValue(low)*yScale))))))
+			bandHigh = append(bandHigh, fyne.NewPos(xx, float32(math.Trunc(float64(yp-(r.clampValue(high)*yScale))))))
+		}
+	}
+	paths := interpolatePaths(upper, style.Interpolation)
+	barOffset, barWidth := r.barSlot(series, xScale)
+
+	for idx, point := range data { // one set of lines
+		thisPoint := upper[idx]
+		pointColor := r.widget.seriesColor(series, *point)
+		if dimmed {
+			pointColor = withAlpha(pointColor, 0x40)
 		}
 
-		dpv := r.dataPoints[series][idx]
-		dpv.Position1 = thisPoint
-		dpv.Position2 = lastPoint
-		lastPoint = thisPoint
+		if style.Mode == SeriesBar {
+			r.drawSegment(series, idx, paths[idx], style, pointColor, &phase, true)
+			r.dataPointMarkers[series][idx].Hide()
+			r.layoutBar(series, idx, thisPoint.X+barOffset, thisPoint.Y, barWidth, yp, pointColor, hidden)
+			zt := fyne.NewPos(thisPoint.X+barOffset, thisPoint.Y)
+			zb := fyne.NewPos(thisPoint.X+barOffset+barWidth, yp)
+			(*point).SetMarkerPosition(&zt, &zb)
+			r.layoutErrorBar(series, idx, *point, thisPoint.X+barOffset+barWidth/2, thisPoint.Y, yScale, barWidth/2, pointColor, hidden)
+			continue
+		}
+		if style.Mode == SeriesCandlestick {
+			r.drawSegment(series, idx, paths[idx], style, pointColor, &phase, true)
+			r.dataPointMarkers[series][idx].Hide()
+			r.layoutCandle(series, idx, *point, thisPoint.X, xScale*0.6, yp, yScale, style, dimmed, hidden)
+			continue
+		}
+		bandOnly := style.Mode == SeriesBand && !style.BandCenterLine
+		r.drawSegment(series, idx, paths[idx], style, pointColor, &phase, hidden || bandOnly || style.Mode == SeriesScatter)
 
-		zt := fyne.NewPos(thisPoint.X-2, thisPoint.Y-2)
 		dpm := r.dataPointMarkers[series][idx]
-		dpm.Position1 = zt
-		zb := fyne.NewPos(thisPoint.X+2, thisPoint.Y+2)
-		dpm.Position2 = zb
+		if !markerMatchesShape(dpm, style.MarkerShape) {
+			dpm = newDataPointMarker(style.MarkerShape, pointColor)
+			r.dataPointMarkers[series][idx] = dpm
+		}
+		placeDataPointMarker(dpm, thisPoint, style.MarkerSize, pointColor)
+		zt := fyne.NewPos(thisPoint.X-half, thisPoint.Y-half)
+		zb := fyne.NewPos(thisPoint.X+half, thisPoint.Y+half)
+		if style.Mode == SeriesBand { // the whole low to high span hovers
+			zt.Y = float32(math.Min(float64(zt.Y), float64(bandHigh[idx].Y)))
+			zb.Y = float32(math.Max(float64(zb.Y), float64(lower[idx].Y)))
+		}
 		(*point).SetMarkerPosition(&zt, &zb)
-		if r.widget.enableDataPointMarkers {
+		r.layoutErrorBar(series, idx, *point, thisPoint.X, thisPoint.Y, yScale, style.MarkerSize+4, pointColor, hidden)
+		if hidden || bandOnly {
+			dpm.Hide()
+			continue
+		}
+		if (r.widget.enableDataPointMarkers || style.Mode == SeriesScatter) && style.MarkerShape != MarkerNone {
 			if !dpm.Visible() {
 				dpm.Show()
 			}
@@ -406,22 +593,286 @@ func (r *lineChartRenderer) layoutSeries(series string) {
 			dpm.Hide()
 		}
 	}
-	var found bool
-correct:
-	for _, o := range r.colorLegend.Objects {
-		if o.(*canvas.Text).Text == series {
-			found = true
-			break correct
-		}
+	r.hideBars(series, style, len(data))
+	if style.Mode == SeriesCandlestick {
+		r.hideErrorBars(series, 0)
+	} else {
+		r.hideErrorBars(series, len(data))
 	}
-	if !found {
-		z := canvas.NewText(series, theme.PrimaryColorNamed((*data[0]).ColorName()))
-		r.colorLegend.Add(z)
+	areaTop := flattenPaths(paths)
+	if style.Mode == SeriesBand {
+		areaTop = flattenPaths(interpolatePaths(bandHigh, style.Interpolation))
+	}
+	if lower != nil {
+		lower = flattenPaths(interpolatePaths(lower, style.Interpolation))
+	}
+	r.layoutArea(series, style, areaTop, lower, yp, hidden, dimmed)
+
+	if len(data) > 0 {
+		label := series
+		if r.widget.enableLegendLastValue {
+			last := (*data[len(data)-1]).Value()
+			label = strings.TrimSpace(fmt.Sprintf("%s: %.1f %s", series, last, r.widget.seriesUnits[series]))
+		}
+		r.legendEntry(series).setAppearance(label, r.widget.seriesColor(series, *data[0]), r.widget.hiddenSeries[series])
 	}
 
 	r.widget.debugLog("lineChartRenderer::layoutSeries() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 }
 
+// clampValue limits a value to the visible y scale
+func (r *lineChartRenderer) clampValue(v float32) float32 {
+	if v > r.widget.dataPointYLimit { // max y chart scale
+		return r.widget.dataPointYLimit
+	} else if v < 0.0 {
+		return 0.0
+	}
+	return v
+}
+
+// stackBase cumulative values, by index, of the visible stacked series sorted before this one
+func (r *lineChartRenderer) stackBase(series string) []float32 {
+	var base []float32
+	for _, name := range r.widget.sortedSeriesNames() {
+		if name == series {
+			break
+		}
+		if r.widget.seriesStyles[name].Mode != SeriesStackedArea {
+			continue
+		}
+		for idx, point := range r.widget.dataPoints[name] {
+			if idx == len(base) {
+				base = append(base, 0)
+			}
+			base[idx] += (*point).Value()
+		}
+	}
+	if base == nil {
+		base = []float32{}
+	}
+	return base
+}
+
+// barSlot x offset from the datapoint and width of the series' bar
+// bar series share each x slot side by side, in series name order
+func (r *lineChartRenderer) barSlot(series string, xScale float32) (float32, float32) {
+	var bars []string
+	for _, name := range r.widget.sortedSeriesNames() {
+		if r.widget.seriesStyles[name].Mode == SeriesBar {
+			bars = append(bars, name)
+		}
+	}
+	group := xScale * 0.8
+	if group < 1 {
+		group = 1
+	}
+	if len(bars) == 0 {
+		return -group / 2, group
+	}
+	width := group / float32(len(bars))
+	for idx, name := range bars {
+		if name == series {
+			return -group/2 + float32(idx)*width, width
+		}
+	}
+	return -group / 2, width
+}
+
+// layoutBar places the datapoint's bar from the baseline up to its value
+func (r *lineChartRenderer) layoutBar(series string, idx int, x, top, width, baseline float32, c color.Color, hidden bool) {
+	for len(r.barRects[series]) <= idx {
+		r.barRects[series] = append(r.barRects[series], canvas.NewRectangle(c))
+	}
+	bar := r.barRects[series][idx]
+	bar.FillColor = c
+	bar.Move(fyne.NewPos(x, top))
+	bar.Resize(fyne.NewSize(width, baseline-top))
+	if hidden {
+		bar.Hide()
+	} else if !bar.Visible() {
+		bar.Show()
+	}
+}
+
+// layoutCandle draws the datapoint's open/close box with its own line as the low/high wick
+// the wick's span becomes the datapoint's hover target
+func (r *lineChartRenderer) layoutCandle(series string, idx int, point ChartDatapoint, x, width, baseline, yScale float32, style SeriesStyle, dimmed, hidden bool) {
+	open, high, low, closed, ok := point.OHLC()
+	if !ok {
+		low, high = bandBounds(point)
+		open = closed
+	}
+	y := func(v float32) float32 {
+		return float32(math.Trunc(float64(baseline - r.clampValue(v)*yScale)))
+	}
+	c := style.UpColor
+	if c == nil {
+		c = theme.PrimaryColorNamed(theme.ColorGreen)
+	}
+	if closed < open {
+		c = style.DownColor
+		if c == nil {
+			c = theme.PrimaryColorNamed(theme.ColorRed)
+		}
+	}
+	if dimmed {
+		c = withAlpha(c, 0x40)
+	} else if style.Opacity < 1 {
+		c = withAlpha(c, uint8(style.Opacity*0xff))
+	}
+	if width < 1 {
+		width = 1
+	}
+
+	wick := r.dataPoints[series][idx]
+	wick.Position1 = fyne.NewPos(x, y(high))
+	wick.Position2 = fyne.NewPos(x, y(low))
+	wick.StrokeColor = c
+	wick.StrokeWidth = 1
+	if hidden {
+		wick.Hide()
+	} else if !wick.Visible() {
+		wick.Show()
+	}
+
+	top := float32(math.Min(float64(y(open)), float64(y(closed))))
+	bottom := float32(math.Max(float64(y(open)), float64(y(closed))))
+	if bottom-top < 1 { // doji, open equals close
+		bottom = top + 1
+	}
+	r.layoutBar(series, idx, x-width/2, top, width, bottom, c, hidden)
+
+	zt := fyne.NewPos(x-width/2, y(high))
+	zb := fyne.NewPos(x+width/2, y(low))
+	point.SetMarkerPosition(&zt, &zb)
+}
+
+// hideBars hides bars past the end of the series, or all of them when the series draws no bars or candles
+func (r *lineChartRenderer) hideBars(series string, style SeriesStyle, count int) {
+	if style.Mode != SeriesBar && style.Mode != SeriesCandlestick {
+		count = 0
+	}
+	for idx, bar := range r.barRects[series] {
+		if idx >= count {
+			bar.Hide()
+		}
+	}
+}
+
+// layoutArea fills under area series, between stacked series, or between a band's bounds, hiding fills of other modes
+func (r *lineChartRenderer) layoutArea(series string, style SeriesStyle, upper, lower []fyne.Position, baseline float32, hidden, dimmed bool) {
+	fill, ok := r.areaFills[series]
+	if style.Mode != SeriesArea && style.Mode != SeriesStackedArea && style.Mode != SeriesBand {
+		if ok {
+			fill.raster.Hide()
+		}
+		return
+	}
+	if !ok {
+		fill = newAreaFill()
+		r.areaFills[series] = fill
+	}
+	if hidden || len(upper) == 0 {
+		fill.raster.Hide()
+		return
+	}
+	alpha := style.FillAlpha
+	if dimmed {
+		alpha /= 4
+	}
+	c := r.widget.seriesColor(series, *r.widget.dataPoints[series][0])
+	fill.update(&areaShape{
+		upper:    upper,
+		lower:    lower,
+		baseline: baseline,
+		fill:     withAlpha(c, alpha).(color.NRGBA),
+		gradient: style.FillGradient,
+		size:     r.widget.Size(),
+	})
+	fill.raster.Show()
+}
+
+// drawSegment strokes the path joining a datapoint to the one before it
+// solid straight segments use the datapoint's own line, dashed or multi-part
+// paths draw with the datapoint's pool of segment lines
+func (r *lineChartRenderer) drawSegment(series string, idx int, path []fyne.Position, style SeriesStyle, c color.Color, phase *float32, hidden bool) {
+	for len(r.segmentLines[series]) <= idx {
+		r.segmentLines[series] = append(r.segmentLines[series], nil)
+	}
+	line := r.dataPoints[series][idx]
+	pool := r.segmentLines[series][idx]
+	used := 0
+
+	if len(style.DashPattern) == 0 && len(path) == 2 {
+		line.Position1 = path[1]
+		line.Position2 = path[0]
+		line.StrokeColor = c
+		line.StrokeWidth = style.StrokeWidth
+		if hidden {
+			line.Hide()
+		} else if !line.Visible() {
+			line.Show()
+		}
+	} else {
+		line.Hide()
+		for i := 1; i < len(path) && !hidden; i++ {
+			for _, dash := range dashSegments(path[i-1], path[i], style.DashPattern, phase) {
+				if used == len(pool) {
+					pool = append(pool, canvas.NewLine(c))
+				}
+				part := pool[used]
+				part.Position1 = dash[0]
+				part.Position2 = dash[1]
+				part.StrokeColor = c
+				part.StrokeWidth = style.StrokeWidth
+				part.Show()
+				used++
+			}
+		}
+	}
+	for _, part := range pool[used:] {
+		part.Hide()
+	}
+	r.segmentLines[series][idx] = pool
+}
+
+// legendEntry returns the series' color legend entry, adding it when missing
+func (r *lineChartRenderer) legendEntry(series string) *legendEntry {
+	for _, o := range r.colorLegend.Objects {
+		if e, ok := o.(*legendEntry); ok && e.series == series {
+			return e
+		}
+	}
+	e := newLegendEntry(series, theme.ForegroundColor(), r.widget.toggleSeriesVisible, r.widget.highlightSeries)
+	r.colorLegend.Objects = placeLegendEntry(r.colorLegend.Objects, e, r.widget.linkedSeries)
+	r.colorLegend.Refresh()
+	return e
+}
+
+// placeLegendEntry adds the entry to the legend objects so smoothed companions follow
+// their series whichever entry is added first
+func placeLegendEntry(legend []fyne.CanvasObject, e *legendEntry, linkedSeries map[string]string) []fyne.CanvasObject {
+	group := []fyne.CanvasObject{e}
+	var objs []fyne.CanvasObject
+	for _, o := range legend {
+		if ce, ok := o.(*legendEntry); ok && linkedSeries[ce.series] == e.series {
+			group = append(group, o) // companions added before their series move behind it
+		} else {
+			objs = append(objs, o)
+		}
+	}
+	at := len(objs)
+	if source, ok := linkedSeries[e.series]; ok {
+		for idx, o := range objs {
+			if se, ok := o.(*legendEntry); ok && se.series == source {
+				at = idx + 1
+				break
+			}
+		}
+	}
+	return append(append(objs[:at:at], group...), objs[at:]...)
+}
+
 // Layout Given the size required by the fyne application
 // move and re-size all custom widget canvas objects here
 func (r *lineChartRenderer) Layout(s fyne.Size) {
@@ -431,7 +882,12 @@ func (r *lineChartRenderer) Layout(s fyne.Size) {
 	r.widget.mapsLock.Lock()
 	defer r.widget.mapsLock.Unlock()
 
-	r.xInc = (s.Width - (theme.Padding() * 4)) / 16.0
+	plotWidth := s.Width - (theme.Padding() * 4)
+	r.legendReserved, r.xOffset = r.legendReservation()
+	if r.legendReserved > 0 {
+		plotWidth -= r.legendReserved + theme.Padding()
+	}
+	r.xInc = plotWidth / 16.0
 	r.yInc = (s.Height - (theme.Padding() * 3)) / 16.0
 
 	r.xInc = float32(math.Trunc(float64(r.xInc)))
@@ -440,7 +896,7 @@ func (r *lineChartRenderer) Layout(s fyne.Size) {
 	// grid Vert lines
 	yp := 14.0 * r.yInc
 	for idx, line := range r.xLines {
-		xp := float32(idx) * r.xInc
+		xp := float32(idx)*r.xInc + r.xOffset
 		line.Position1 = fyne.NewPos(xp+r.xInc, r.yInc) //top
 		line.Position2 = fyne.NewPos(xp+r.xInc, yp+8)
 	}
@@ -449,20 +905,20 @@ func (r *lineChartRenderer) Layout(s fyne.Size) {
 	xp := r.xInc
 	for idx, line := range r.yLines {
 		yp := float32(idx) * r.yInc
-		line.Position1 = fyne.NewPos(xp-8, yp+r.yInc) // left
-		line.Position2 = fyne.NewPos(xp*16, yp+r.yInc)
+		line.Position1 = fyne.NewPos(xp-8+r.xOffset, yp+r.yInc) // left
+		line.Position2 = fyne.NewPos(xp*16+r.xOffset, yp+r.yInc)
 	}
 
 	// grid scale labels
 	xp = r.xInc
 	yp = 14.0 * r.yInc
 	for idx, label := range r.xLabels {
-		xxp := float32(idx+1) * r.xInc // starting at left
+		xxp := float32(idx+1)*r.xInc + r.xOffset // starting at left
 		label.Move(fyne.NewPos(xxp+8, yp+10))
 	}
 	for idx, label := range r.yLabels {
 		yyp := float32(idx+1) * r.yInc // starting at top
-		label.Move(fyne.NewPos(xp*0.80, yyp-8))
+		label.Move(fyne.NewPos(xp*0.80+r.xOffset, yyp-8))
 	}
 
 	// handle new data points or series
@@ -490,10 +946,9 @@ func (r *lineChartRenderer) Layout(s fyne.Size) {
 	r.topRightDesc.Move(fyne.Position{X: (s.Width - ts.Width) - theme.Padding(), Y: ts.Height / 4})
 	r.topLeftDesc.Move(fyne.NewPos(theme.Padding(), ts.Height/4))
 
-	msg := strings.Split(r.mouseDisplayContainer.Objects[1].(*widget.Label).Text, "[")
-	ts = fyne.MeasureText(msg[0], 14, r.mouseDisplayContainer.Objects[1].(*widget.Label).TextStyle)
-	r.mouseDisplayContainer.Objects[1].(*widget.Label).Resize(fyne.NewSize(ts.Width-theme.Padding(), (2*ts.Height)+(theme.Padding()/2))) // allow room for wrap
-	r.mouseDisplayContainer.Objects[0].(*canvas.Rectangle).Resize(fyne.NewSize(ts.Width+theme.Padding(), (2*ts.Height)+theme.Padding()))
+	ts, rows := r.measureHoverText(r.mouseDisplayContainer.Objects[1].(*widget.Label))
+	r.mouseDisplayContainer.Objects[1].(*widget.Label).Resize(fyne.NewSize(ts.Width-theme.Padding(), (rows*ts.Height)+(theme.Padding()/2))) // allow room for wrap
+	r.mouseDisplayContainer.Objects[0].(*canvas.Rectangle).Resize(fyne.NewSize(ts.Width+theme.Padding(), (rows*ts.Height)+theme.Padding()))
 	// top edge
 	if r.widget.mouseDisplayPosition.Y < theme.Padding()/6 {
 		r.widget.mouseDisplayPosition.Y = theme.Padding() / 6
@@ -528,12 +983,33 @@ func (r *lineChartRenderer) Layout(s fyne.Size) {
 	r.bottomRightDesc.Move(fyne.NewPos((s.Width-ts.Width)-theme.Padding(), s.Height-ts.Height-theme.Padding()))
 	r.bottomLeftDesc.Move(fyne.NewPos(theme.Padding()+2.0, s.Height-ts.Height-theme.Padding()))
 
-	z := r.colorLegend.MinSize()
-	r.colorLegend.Move(fyne.NewPos(s.Width-(z.Width+theme.Padding()), (r.yInc*15)+theme.Padding()))
+	r.layoutLegend(s)
+
+	r.layoutSelection()
 
 	r.widget.debugLog("lineChartRenderer::Layout() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 }
 
+// measureHoverText size of the widest popup line, up to any timestamp bracket, and the rows needed
+// a single line still reserves two rows to allow room for wrap
+func (r *lineChartRenderer) measureHoverText(label *widget.Label) (fyne.Size, float32) {
+	var size fyne.Size
+	lines := strings.Split(label.Text, "\n")
+	for _, line := range lines {
+		msg := strings.Split(line, "[")
+		ts := fyne.MeasureText(msg[0], 14, label.TextStyle)
+		if ts.Width > size.Width {
+			size.Width = ts.Width
+		}
+		size.Height = ts.Height
+	}
+	rows := float32(len(lines))
+	if rows < 2 {
+		rows = 2
+	}
+	return size, rows
+}
+
 // MinSize Create a minimum size for the widget.
 // The smallest size is can be overridden by user
 func (r *lineChartRenderer) MinSize() fyne.Size {
@@ -555,15 +1031,45 @@ func (r *lineChartRenderer) Objects() []fyne.CanvasObject {
 
 	var objs []fyne.CanvasObject
 	objs = append(objs, r.widget.objectsCache...)
+	objs = append(objs, r.referenceObjects()...)
+	objs = append(objs, r.eventObjects()...)
+	objs = append(objs, r.selectionBox)
+	for _, fill := range r.areaFills {
+		objs = append(objs, fill.raster)
+	}
+	for _, bars := range r.barRects {
+		for _, bar := range bars {
+			objs = append(objs, bar)
+		}
+	}
 
 	for key, lines := range r.dataPoints {
 		for idx, line := range lines {
 			marker := r.dataPointMarkers[key][idx]
 			objs = append(objs, marker, line)
+			if idx < len(r.segmentLines[key]) {
+				for _, part := range r.segmentLines[key][idx] {
+					objs = append(objs, part)
+				}
+			}
 		}
 	}
 
-	objs = append(objs, r.colorLegend, r.mouseDisplayContainer)
+	for _, bars := range r.errorBars {
+		for _, bar := range bars {
+			for _, line := range bar.lines() {
+				objs = append(objs, line)
+			}
+		}
+	}
+	for _, marker := range r.xyMarkers {
+		objs = append(objs, marker)
+	}
+	objs = append(objs, r.xyRegression)
+	for _, label := range r.annotationLabels {
+		objs = append(objs, label)
+	}
+	objs = append(objs, r.legendFrame, r.colorLegend, r.mouseDisplayContainer)
 
 	r.widget.debugLog("lineChartRenderer::Objects() EXIT cnt: ", len(objs), ", Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 	return objs
@@ -577,6 +1083,9 @@ func (r *lineChartRenderer) Destroy() {
 		r.widget.dataPoints[key] = r.widget.dataPoints[key][:0]
 		r.dataPoints[key] = r.dataPoints[key][:0]
 		r.dataPointMarkers[key] = r.dataPointMarkers[key][:0]
+		r.segmentLines[key] = r.segmentLines[key][:0]
+		r.barRects[key] = r.barRects[key][:0]
+		r.errorBars[key] = r.errorBars[key][:0]
 	}
 	r.widget.debugLog("lineChartRenderer::Destroy() EXIT cnt: ", len(r.widget.objectsCache))
 }
@@ -595,36 +1104,54 @@ func (r *lineChartRenderer) verifyDataPoints(protect bool) {
 
 	var changedKeys []string
 	var changed bool
-	strokeSize := r.widget.dataPointStrokeSize
-	markerSize := strokeSize * 5
 	for key, points := range r.widget.dataPoints {
 		changed = false
 		if nil == r.dataPoints[key] {
 			r.dataPoints[key] = []*canvas.Line{}
-			r.dataPointMarkers[key] = []*canvas.Circle{}
+			r.dataPointMarkers[key] = []fyne.CanvasObject{}
 			changed = true
 		}
 		for idx, point := range points {
 			if idx > (len(r.dataPoints[key]) - 1) { // add added points
 				changed = true
-				x := canvas.NewLine(theme.PrimaryColorNamed((*point).ColorName()))
-				x.StrokeWidth = strokeSize
+				style := r.widget.seriesStyle(key)
+				x := canvas.NewLine(r.widget.seriesColor(key, *point))
+				x.StrokeWidth = style.StrokeWidth
 				r.dataPoints[key] = append(r.dataPoints[key], x)
-				z := canvas.NewCircle(theme.PrimaryColorNamed((*point).ColorName()))
-				z.StrokeWidth = strokeSize * 2
-				z.Resize(fyne.NewSize(markerSize, markerSize))
-				r.dataPointMarkers[key] = append(r.dataPointMarkers[key], z)
+				r.dataPointMarkers[key] = append(r.dataPointMarkers[key], newDataPointMarker(style.MarkerShape, r.widget.seriesColor(key, *point)))
 			}
 		}
 		if changed {
 			changedKeys = append(changedKeys, key)
 		}
 	}
+	for _, mode := range []SeriesMode{SeriesStackedArea, SeriesBar} { // stacked and grouped series move together
+		for _, key := range changedKeys {
+			if r.widget.seriesStyles[key].Mode == mode {
+				for name := range r.widget.dataPoints {
+					if r.widget.seriesStyles[name].Mode == mode && name != key {
+						changedKeys = append(changedKeys, name)
+					}
+				}
+				break
+			}
+		}
+	}
+	if r.widget.seriesLayoutStale {
+		changedKeys = changedKeys[:0]
+		for key := range r.widget.dataPoints {
+			changedKeys = append(changedKeys, key)
+		}
+		r.widget.seriesLayoutStale = false
+	}
 	if len(changedKeys) > 0 {
 		for _, series := range changedKeys {
 			r.layoutSeries(series)
 		}
 		r.widget.dataSeriesAdded = false
 	}
+	r.layoutXY()
+	r.layoutReferences()
+	r.layoutEvents()
 	r.widget.debugLog("lineChartRenderer::VerifyDataPoints() EXIT. Elapsed.microseconds: ", time.Until(startTime).Microseconds())
 }
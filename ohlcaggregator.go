@@ -0,0 +1,85 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OHLCAggregator buckets a stream of values into open/high/low/close
+// datapoints, one per bucket duration, for candlestick series; safe for concurrent use
+type OHLCAggregator struct {
+	mu         sync.Mutex
+	seriesName string
+	bucket     time.Duration
+	start      time.Time
+	open       float32
+	high       float32
+	low        float32
+	close      float32
+	count      int
+}
+
+// NewOHLCAggregator buckets values by the bucket duration, which must be positive
+func NewOHLCAggregator(seriesName string, bucket time.Duration) (*OHLCAggregator, error) {
+	if bucket <= 0 {
+		return nil, fmt.Errorf("[%s] ohlc bucket must be positive, got %v", seriesName, bucket)
+	}
+	return &OHLCAggregator{
+		seriesName: seriesName,
+		bucket:     bucket,
+	}, nil
+}
+
+// AddValue folds the value into the bucket containing its time, when the value
+// starts a new bucket the completed bucket's datapoint is returned with true;
+// values older than the bucket in progress are folded into it
+func (a *OHLCAggregator) AddValue(value float32, at time.Time) (ChartDatapoint, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	start := at.Truncate(a.bucket)
+	var done ChartDatapoint
+	var ok bool
+	if a.count > 0 && start.After(a.start) {
+		done, ok = a.flush()
+	}
+	if a.count == 0 {
+		a.start = start
+		a.open, a.high, a.low = value, value, value
+	}
+	if value > a.high {
+		a.high = value
+	}
+	if value < a.low {
+		a.low = value
+	}
+	a.close = value
+	a.count++
+	return done, ok
+}
+
+// Flush returns the bucket in progress, false when it is empty, and starts a new one
+func (a *OHLCAggregator) Flush() (ChartDatapoint, bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.flush()
+}
+
+// flush completes the bucket in progress, caller holds the lock
+func (a *OHLCAggregator) flush() (ChartDatapoint, bool) {
+	if a.count == 0 {
+		return nil, false
+	}
+	point := NewChartDatapointOHLC(a.open, a.high, a.low, a.close, a.start.Format(time.RFC1123))
+	a.count = 0
+	return point, true
+}
+func (a *OHLCAggregator) SeriesName() string {
+	return strings.Clone(a.seriesName)
+}
+func (a *OHLCAggregator) String() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return fmt.Sprint("series:", a.seriesName, ", bucket:", a.bucket, ", count:", a.count)
+}
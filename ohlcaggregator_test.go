@@ -0,0 +1,69 @@
+package sknlinechart_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"sync"
+	"time"
+)
+
+var _ = Describe("OHLC aggregator", func() {
+
+	It("should bucket a stream of values into candlestick datapoints", func() {
+		agg, err := sknlinechart.NewOHLCAggregator("Voltage", time.Minute)
+		Expect(err).NotTo(HaveOccurred())
+		start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		By("Folding values within the same minute")
+		for idx, v := range []float32{12.4, 12.9, 12.1, 12.6} {
+			_, ok := agg.AddValue(v, start.Add(time.Duration(idx)*10*time.Second))
+			Expect(ok).To(BeFalse())
+		}
+
+		By("Completing the bucket when the next minute starts")
+		point, ok := agg.AddValue(12.2, start.Add(time.Minute))
+		Expect(ok).To(BeTrue())
+		open, high, low, closed, isOHLC := point.OHLC()
+		Expect(isOHLC).To(BeTrue())
+		Expect([]float32{open, high, low, closed}).To(Equal([]float32{12.4, 12.9, 12.1, 12.6}))
+		Expect(point.Value()).To(Equal(float32(12.6)))
+		Expect(point.Timestamp()).To(Equal(start.Format(time.RFC1123)))
+
+		By("Flushing the bucket in progress")
+		point, ok = agg.Flush()
+		Expect(ok).To(BeTrue())
+		open, _, _, closed, _ = point.OHLC()
+		Expect(open).To(Equal(closed))
+		_, ok = agg.Flush()
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should reject buckets that are not positive", func() {
+		for _, bucket := range []time.Duration{0, -time.Minute} {
+			_, err := sknlinechart.NewOHLCAggregator("Voltage", bucket)
+			Expect(err).To(HaveOccurred(), "bucket %v", bucket)
+		}
+	})
+
+	It("should accept values from several goroutines", func() {
+		agg, err := sknlinechart.NewOHLCAggregator("Voltage", time.Hour)
+		Expect(err).NotTo(HaveOccurred())
+		start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+		var wg sync.WaitGroup
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					agg.AddValue(float32(g*100+i), start)
+				}
+			}(g)
+		}
+		wg.Wait()
+		point, ok := agg.Flush()
+		Expect(ok).To(BeTrue())
+		_, high, low, _, _ := point.OHLC()
+		Expect([]float32{high, low}).To(Equal([]float32{399, 0}))
+	})
+})
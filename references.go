@@ -0,0 +1,132 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"image/color"
+)
+
+// ScaleAxis side of the plot a threshold's label is anchored to
+type ScaleAxis int
+
+const (
+	LeftAxis  ScaleAxis = iota // label at the left scale
+	RightAxis                  // label at the right scale
+)
+
+// threshold horizontal reference line at a y value
+type threshold struct {
+	value float32
+	label string
+	color color.Color
+	axis  ScaleAxis
+}
+
+// zone horizontal band between two y values
+type zone struct {
+	min   float32
+	max   float32
+	color color.Color
+	label string
+}
+
+// zoneFill translucent version of the zone color, colors given with alpha are used as is
+func zoneFill(c color.Color) color.Color {
+	if _, _, _, a := c.RGBA(); a == 0xffff {
+		return withAlpha(c, 0x30)
+	}
+	return c
+}
+
+// layoutReferences places threshold lines and zone bands behind the series,
+// hiding those outside the current y scale
+func (r *lineChartRenderer) layoutReferences() {
+	left := r.xInc + r.xOffset
+	right := r.xInc*16 + r.xOffset
+	yp := r.yInc * 14.0
+	yScale := (r.yInc * 10) / (10.0 * float32(r.widget.chartScaleMultiplier))
+
+	for len(r.zoneRects) < len(r.widget.zones) {
+		r.zoneRects = append(r.zoneRects, canvas.NewRectangle(color.Transparent))
+		r.zoneLabels = append(r.zoneLabels, newReferenceLabel())
+	}
+	for idx, rect := range r.zoneRects {
+		label := r.zoneLabels[idx]
+		if idx >= len(r.widget.zones) {
+			rect.Hide()
+			label.Hide()
+			continue
+		}
+		z := r.widget.zones[idx]
+		if z.max < z.min {
+			z.min, z.max = z.max, z.min
+		}
+		if z.min > r.widget.dataPointYLimit || z.max < 0 {
+			rect.Hide()
+			label.Hide()
+			continue
+		}
+		top := yp - r.clampValue(z.max)*yScale
+		bottom := yp - r.clampValue(z.min)*yScale
+		rect.FillColor = zoneFill(z.color)
+		rect.Move(fyne.NewPos(left, top))
+		rect.Resize(fyne.NewSize(right-left, bottom-top))
+		rect.Show()
+		label.Text = z.label
+		label.Color = z.color
+		label.Move(fyne.NewPos(left+4, top))
+		label.Show()
+	}
+
+	for len(r.thresholdLines) < len(r.widget.thresholds) {
+		r.thresholdLines = append(r.thresholdLines, canvas.NewLine(color.Transparent))
+		r.thresholdLabels = append(r.thresholdLabels, newReferenceLabel())
+	}
+	for idx, line := range r.thresholdLines {
+		label := r.thresholdLabels[idx]
+		if idx >= len(r.widget.thresholds) {
+			line.Hide()
+			label.Hide()
+			continue
+		}
+		t := r.widget.thresholds[idx]
+		if t.value < 0 || t.value > r.widget.dataPointYLimit {
+			line.Hide()
+			label.Hide()
+			continue
+		}
+		y := yp - t.value*yScale
+		line.Position1 = fyne.NewPos(left, y)
+		line.Position2 = fyne.NewPos(right, y)
+		line.StrokeColor = t.color
+		line.StrokeWidth = 1.5
+		line.Show()
+		label.Text = t.label
+		label.Color = t.color
+		size := fyne.MeasureText(t.label, label.TextSize, label.TextStyle)
+		x := left + 4
+		if t.axis == RightAxis {
+			x = right - size.Width - 4
+		}
+		label.Move(fyne.NewPos(x, y-size.Height))
+		label.Show()
+	}
+}
+
+// referenceObjects zone bands, then threshold lines, with their labels
+func (r *lineChartRenderer) referenceObjects() []fyne.CanvasObject {
+	var objs []fyne.CanvasObject
+	for idx, rect := range r.zoneRects {
+		objs = append(objs, rect, r.zoneLabels[idx])
+	}
+	for idx, line := range r.thresholdLines {
+		objs = append(objs, line, r.thresholdLabels[idx])
+	}
+	return objs
+}
+
+func newReferenceLabel() *canvas.Text {
+	label := canvas.NewText("", color.Transparent)
+	label.TextSize = 11
+	return label
+}
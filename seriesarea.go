@@ -0,0 +1,89 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"image/color"
+	"sort"
+	"sync/atomic"
+)
+
+// areaShape region between an upper and lower edge, in chart coordinates
+// a nil lower edge fills down to the baseline
+type areaShape struct {
+	upper    []fyne.Position
+	lower    []fyne.Position
+	baseline float32
+	fill     color.NRGBA
+	gradient bool
+	size     fyne.Size
+}
+
+// areaFill raster painting an area series; the shape is swapped atomically
+// since the raster is painted outside the chart's maps lock
+type areaFill struct {
+	raster *canvas.Raster
+	shape  atomic.Pointer[areaShape]
+}
+
+func newAreaFill() *areaFill {
+	a := &areaFill{}
+	a.raster = canvas.NewRasterWithPixels(a.pixel)
+	return a
+}
+
+// update replaces the painted shape and repaints
+func (a *areaFill) update(shape *areaShape) {
+	a.shape.Store(shape)
+	a.raster.Move(fyne.NewPos(0, 0))
+	a.raster.Resize(shape.size)
+	a.raster.Refresh()
+}
+
+// pixel color of one raster pixel, transparent outside the area
+func (a *areaFill) pixel(x, y, w, h int) color.Color {
+	shape := a.shape.Load()
+	if shape == nil || w == 0 || h == 0 {
+		return color.Transparent
+	}
+	fx := float32(x) * shape.size.Width / float32(w)
+	fy := float32(y) * shape.size.Height / float32(h)
+
+	top, ok := edgeY(shape.upper, fx)
+	if !ok {
+		return color.Transparent
+	}
+	bottom := shape.baseline
+	if shape.lower != nil {
+		if bottom, ok = edgeY(shape.lower, fx); !ok {
+			return color.Transparent
+		}
+	}
+	if top > bottom {
+		top, bottom = bottom, top
+	}
+	if fy < top || fy > bottom {
+		return color.Transparent
+	}
+	fill := shape.fill
+	if shape.gradient && bottom > top {
+		fill.A = uint8(float32(fill.A) * (bottom - fy) / (bottom - top))
+	}
+	return fill
+}
+
+// edgeY interpolates the edge's y at x, false when x is outside the edge
+func edgeY(edge []fyne.Position, x float32) (float32, bool) {
+	if len(edge) == 0 || x < edge[0].X || x > edge[len(edge)-1].X {
+		return 0, false
+	}
+	idx := sort.Search(len(edge), func(i int) bool { return edge[i].X >= x })
+	if idx == 0 {
+		return edge[0].Y, true
+	}
+	a, b := edge[idx-1], edge[idx]
+	if b.X == a.X {
+		return b.Y, true
+	}
+	return a.Y + (b.Y-a.Y)*(x-a.X)/(b.X-a.X), true
+}
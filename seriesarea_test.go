@@ -0,0 +1,69 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"image/color"
+	"time"
+)
+
+var _ = Describe("Area fills", func() {
+
+	It("should stack each series on the visible stacked series sorted before it", func() {
+		dataPoints := map[string][]*sknlinechart.ChartDatapoint{}
+		add := func(series string, values ...float32) {
+			for _, v := range values {
+				point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, time.Now().Format(time.RFC1123))
+				dataPoints[series] = append(dataPoints[series], &point)
+			}
+		}
+		add("A", 1, 2, 3)
+		add("B", 10, 20)
+		add("C", 100, 200, 300)
+		add("Line", 1000, 1000, 1000)
+		lc, _ := sknlinechart.NewLineChart("Stacked", "Areas", 100, &dataPoints)
+		for _, series := range []string{"A", "B", "C"} {
+			Expect(lc.SetSeriesStyle(series, sknlinechart.SeriesStyle{Mode: sknlinechart.SeriesStackedArea})).To(Succeed())
+		}
+
+		Expect(sknlinechart.StackBase(lc, "A")).To(BeEmpty())
+		Expect(sknlinechart.StackBase(lc, "B")).To(Equal([]float32{1, 2, 3}))
+		Expect(sknlinechart.StackBase(lc, "C")).To(Equal([]float32{11, 22, 3}))
+
+		By("leaving hidden series out of the stack")
+		lc.SetSeriesVisible("B", false)
+		Expect(sknlinechart.StackBase(lc, "C")).To(Equal([]float32{1, 2, 3}))
+	})
+
+	It("should paint only between the edges, within their x range", func() {
+		fill := color.NRGBA{R: 0xff, A: 0x80}
+		upper := []fyne.Position{fyne.NewPos(20, 10), fyne.NewPos(80, 30)}
+		pixel := sknlinechart.AreaFillPixel(upper, nil, 50, fill, false, fyne.NewSize(100, 100))
+
+		Expect(pixel(50, 15, 100, 100)).To(Equal(color.Transparent)) // above the upper edge at y 20
+		Expect(pixel(50, 25, 100, 100)).To(Equal(fill))
+		Expect(pixel(50, 50, 100, 100)).To(Equal(fill))
+		Expect(pixel(50, 51, 100, 100)).To(Equal(color.Transparent)) // below the baseline
+		Expect(pixel(10, 40, 100, 100)).To(Equal(color.Transparent)) // left of the first point
+		Expect(pixel(90, 40, 100, 100)).To(Equal(color.Transparent)) // right of the last point
+
+		By("scaling raster pixels to chart coordinates")
+		Expect(pixel(100, 50, 200, 200)).To(Equal(fill))
+		Expect(pixel(100, 30, 200, 200)).To(Equal(color.Transparent))
+
+		By("filling between a lower edge rather than the baseline")
+		lower := []fyne.Position{fyne.NewPos(20, 40), fyne.NewPos(80, 40)}
+		pixel = sknlinechart.AreaFillPixel(upper, lower, 50, fill, false, fyne.NewSize(100, 100))
+		Expect(pixel(50, 35, 100, 100)).To(Equal(fill))
+		Expect(pixel(50, 45, 100, 100)).To(Equal(color.Transparent))
+
+		By("fading out toward the baseline")
+		pixel = sknlinechart.AreaFillPixel(upper, nil, 50, fill, true, fyne.NewSize(100, 100))
+		near := pixel(50, 21, 100, 100).(color.NRGBA)
+		far := pixel(50, 49, 100, 100).(color.NRGBA)
+		Expect(near.A).To(BeNumerically(">", far.A))
+	})
+})
@@ -0,0 +1,43 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Band series", func() {
+
+	It("should fill between the low and high bounds and hover over the whole span", func() {
+		lc := renderedUI()
+		Expect(lc.SetSeriesStyle("Band", sknlinechart.SeriesStyle{Mode: sknlinechart.SeriesBand})).To(Succeed())
+		var points []sknlinechart.ChartDatapoint
+		for idx, v := range []float32{30, 35, 40} {
+			point := sknlinechart.NewChartDatapointBand(v-10, v, v+10, theme.ColorBlue, "Mon, 02 Jan 2006 15:04:05 MST")
+			points = append(points, point)
+			lc.ApplyDataPoint("Band", &points[idx])
+		}
+
+		upper, lower, shown := sknlinechart.AreaEdges(lc, "Band")
+		Expect(shown).To(BeTrue())
+		Expect(upper).To(HaveLen(3))
+		Expect(lower).To(HaveLen(3))
+		for idx, v := range []float32{30, 35, 40} {
+			Expect(upper[idx].Y).To(Equal(sknlinechart.ValueY(lc, v+10)))
+			Expect(lower[idx].Y).To(Equal(sknlinechart.ValueY(lc, v-10)))
+			Expect(upper[idx].X).To(Equal(lower[idx].X))
+		}
+
+		By("hovering anywhere from the low to the high bound")
+		top, bottom := points[1].MarkerPosition()
+		Expect(top.Y).To(BeNumerically("<=", sknlinechart.ValueY(lc, 45)))
+		Expect(bottom.Y).To(BeNumerically(">=", sknlinechart.ValueY(lc, 25)))
+		Expect(hoverOver(lc, points[1])).To(Equal("Band, Index: 1, Low: 25, Mid: 35, High: 45    [Mon, 02 Jan 2006 15:04:05 MST]"))
+
+		By("hiding the fill with the series")
+		lc.SetSeriesVisible("Band", false)
+		_, _, shown = sknlinechart.AreaEdges(lc, "Band")
+		Expect(shown).To(BeFalse())
+	})
+})
@@ -0,0 +1,53 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"time"
+)
+
+var _ = Describe("Bar series", func() {
+
+	It("should group bar series side by side in series name order", func() {
+		dataPoints := map[string][]*sknlinechart.ChartDatapoint{}
+		for _, series := range []string{"Cherry", "Apple", "Banana", "Line"} {
+			for _, v := range []float32{20, 40, 60} {
+				point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, time.Now().Format(time.RFC1123))
+				dataPoints[series] = append(dataPoints[series], &point)
+			}
+		}
+		lc, _ := sknlinechart.NewLineChart("Bars", "Grouped", 10, &dataPoints)
+		for _, series := range []string{"Apple", "Banana", "Cherry"} {
+			Expect(lc.SetSeriesStyle(series, sknlinechart.SeriesStyle{Mode: sknlinechart.SeriesBar})).To(Succeed())
+		}
+
+		offset, width := sknlinechart.BarSlot(lc, "Apple", 30)
+		Expect([]float32{offset, width}).To(Equal([]float32{-12, 8}))
+		offset, _ = sknlinechart.BarSlot(lc, "Banana", 30)
+		Expect(offset).To(Equal(float32(-4)))
+		offset, _ = sknlinechart.BarSlot(lc, "Cherry", 30)
+		Expect(offset).To(Equal(float32(4)))
+
+		By("giving hidden series' room to the visible bars")
+		lc.SetSeriesVisible("Banana", false)
+		offset, width = sknlinechart.BarSlot(lc, "Cherry", 30)
+		Expect([]float32{offset, width}).To(Equal([]float32{0, 12}))
+		lc.SetSeriesVisible("Banana", true)
+
+		By("drawing the bars of a slot beside each other from the baseline")
+		lc.Resize(fyne.NewSize(800, 400))
+		lc.Refresh()
+		apple, banana := sknlinechart.BarRects(lc, "Apple"), sknlinechart.BarRects(lc, "Banana")
+		Expect(apple).To(HaveLen(3))
+		Expect(banana).To(HaveLen(3))
+		for idx := range apple {
+			Expect(apple[idx].Position().X + apple[idx].Size().Width).To(BeNumerically("~", banana[idx].Position().X, 0.5))
+			Expect(apple[idx].Position().Y + apple[idx].Size().Height).To(Equal(banana[idx].Position().Y + banana[idx].Size().Height))
+		}
+		Expect(apple[2].Size().Height).To(BeNumerically("~", 3*apple[0].Size().Height, 2))
+		Expect(sknlinechart.BarRects(lc, "Line")).To(BeEmpty())
+	})
+})
@@ -0,0 +1,50 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("Candlestick series", func() {
+
+	It("should draw wicks from low to high and bodies from open to close", func() {
+		lc := renderedUI()
+		Expect(lc.SetSeriesStyle("Candles", sknlinechart.SeriesStyle{Mode: sknlinechart.SeriesCandlestick})).To(Succeed())
+		points := []sknlinechart.ChartDatapoint{
+			sknlinechart.NewChartDatapointOHLC(30, 50, 20, 40, "Mon, 02 Jan 2006 15:04:05 MST"), // up
+			sknlinechart.NewChartDatapointOHLC(40, 45, 25, 30, "Mon, 02 Jan 2006 15:05:05 MST"), // down
+			sknlinechart.NewChartDatapointOHLC(35, 40, 30, 35, "Mon, 02 Jan 2006 15:06:05 MST"), // doji
+		}
+		for idx := range points {
+			lc.ApplyDataPoint("Candles", &points[idx])
+		}
+		y := func(v float32) float32 { return sknlinechart.ValueY(lc, v) }
+		up, down := theme.PrimaryColorNamed(theme.ColorGreen), theme.PrimaryColorNamed(theme.ColorRed)
+
+		wick, body := sknlinechart.Candle(lc, "Candles", 0)
+		Expect([]float32{wick.Position1.Y, wick.Position2.Y}).To(Equal([]float32{y(50), y(20)}))
+		Expect(body.Position().Y).To(Equal(y(40)))
+		Expect(body.Position().Y + body.Size().Height).To(Equal(y(30)))
+		Expect(wick.Position1.X).To(BeNumerically("~", body.Position().X+body.Size().Width/2, 0.5))
+		Expect(wick.StrokeColor).To(Equal(up))
+		Expect(body.FillColor).To(Equal(up))
+
+		By("coloring a candle closing below its open down")
+		wick, body = sknlinechart.Candle(lc, "Candles", 1)
+		Expect([]float32{wick.Position1.Y, wick.Position2.Y}).To(Equal([]float32{y(45), y(25)}))
+		Expect(body.Position().Y).To(Equal(y(40)))
+		Expect(body.Position().Y + body.Size().Height).To(Equal(y(30)))
+		Expect(wick.StrokeColor).To(Equal(down))
+		Expect(body.FillColor).To(Equal(down))
+
+		By("keeping a doji body visible")
+		_, body = sknlinechart.Candle(lc, "Candles", 2)
+		Expect(body.Position().Y).To(Equal(y(35)))
+		Expect(body.Size().Height).To(Equal(float32(1)))
+
+		By("showing open, high, low and close when hovered")
+		Expect(hoverOver(lc, points[1])).To(Equal("Candles, Index: 1, Open: 40, High: 45, Low: 25, Close: 30    [Mon, 02 Jan 2006 15:05:05 MST]"))
+	})
+})
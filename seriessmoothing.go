@@ -0,0 +1,184 @@
+package sknlinechart
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"math"
+)
+
+// SavitzkyGolay smooths a whole series by fitting a polynomial of the given order
+// over a window of points around each point, preserving peak heights and widths
+// better than moving averages; window must be odd and greater than order.
+// Returns a new series suitable for ApplyDataSeries
+func SavitzkyGolay(points []*ChartDatapoint, window, order int) ([]*ChartDatapoint, error) {
+	if window < 3 || window%2 == 0 {
+		return nil, fmt.Errorf("savitzky-golay window must be odd and at least 3, got %d", window)
+	}
+	if order < 0 || order >= window {
+		return nil, fmt.Errorf("savitzky-golay order must be from 0 to window-1, got %d", order)
+	}
+	if len(points) < window {
+		return nil, fmt.Errorf("savitzky-golay needs at least window points, points: %d, window: %d", len(points), window)
+	}
+	smoothed := make([]*ChartDatapoint, len(points))
+	for idx := range points {
+		lo := idx - window/2 // near the ends the window shifts inward
+		if lo < 0 {
+			lo = 0
+		}
+		if lo+window > len(points) {
+			lo = len(points) - window
+		}
+		xs, ws := fitWindow(lo, lo+window, idx)
+		smoothed[idx] = smoothedPoint(*points[idx], func(channel pointChannel) float64 {
+			return polyFitAt(xs, channelValues(points, lo, lo+window, channel), ws, order)
+		})
+	}
+	return smoothed, nil
+}
+
+// Loess smooths a whole series with locally weighted regression: each point is
+// fitted by a polynomial of degree 1 or 2 over the span fraction of nearest points,
+// weighted by distance. Returns a new series suitable for ApplyDataSeries
+func Loess(points []*ChartDatapoint, span float64, degree int) ([]*ChartDatapoint, error) {
+	if span <= 0 || span > 1 {
+		return nil, fmt.Errorf("loess span must be greater than 0.0 and at most 1.0, got %.2f", span)
+	}
+	if degree < 1 || degree > 2 {
+		return nil, fmt.Errorf("loess degree must be 1 or 2, got %d", degree)
+	}
+	neighbours := int(math.Ceil(span * float64(len(points))))
+	if neighbours < degree+1 {
+		neighbours = degree + 1
+	}
+	if len(points) < neighbours {
+		return nil, errors.New("loess needs more points than the polynomial degree")
+	}
+	smoothed := make([]*ChartDatapoint, len(points))
+	for idx := range points {
+		lo := idx - neighbours/2 // nearest points by index
+		if lo < 0 {
+			lo = 0
+		}
+		if lo+neighbours > len(points) {
+			lo = len(points) - neighbours
+		}
+		xs, _ := fitWindow(lo, lo+neighbours, idx)
+		reach := math.Max(math.Abs(xs[0]), math.Abs(xs[len(xs)-1])) + 1
+		ws := make([]float64, len(xs))
+		for j, x := range xs { // tricube weights
+			d := math.Abs(x) / reach
+			ws[j] = math.Pow(1-d*d*d, 3)
+		}
+		smoothed[idx] = smoothedPoint(*points[idx], func(channel pointChannel) float64 {
+			return polyFitAt(xs, channelValues(points, lo, lo+neighbours, channel), ws, degree)
+		})
+	}
+	return smoothed, nil
+}
+
+// fitWindow x of the points from lo to hi relative to the point being smoothed, unit weights
+func fitWindow(lo, hi, at int) ([]float64, []float64) {
+	xs := make([]float64, 0, hi-lo)
+	ws := make([]float64, 0, hi-lo)
+	for j := lo; j < hi; j++ {
+		xs = append(xs, float64(j-at))
+		ws = append(ws, 1)
+	}
+	return xs, ws
+}
+
+// pointChannel one of the values a datapoint carries
+type pointChannel func(point ChartDatapoint) float32
+
+var (
+	valueChannel pointChannel = ChartDatapoint.Value
+	lowChannel   pointChannel = func(point ChartDatapoint) float32 { low, _ := bandBounds(point); return low }
+	highChannel  pointChannel = func(point ChartDatapoint) float32 { _, high := bandBounds(point); return high }
+	openChannel  pointChannel = func(point ChartDatapoint) float32 {
+		if open, _, _, _, ok := point.OHLC(); ok {
+			return open
+		}
+		return point.Value()
+	}
+)
+
+// channelValues the channel's values of the points from lo to hi
+func channelValues(points []*ChartDatapoint, lo, hi int, channel pointChannel) []float64 {
+	ys := make([]float64, 0, hi-lo)
+	for j := lo; j < hi; j++ {
+		ys = append(ys, float64(channel(*points[j])))
+	}
+	return ys
+}
+
+// polyFitAt weighted least squares polynomial fit of the given order, evaluated at x = 0
+func polyFitAt(xs, ys, ws []float64, order int) float64 {
+	n := order + 1
+	// normal equations, augmented with the right hand side
+	m := make([][]float64, n)
+	for r := range m {
+		m[r] = make([]float64, n+1)
+		for j, x := range xs {
+			xr := math.Pow(x, float64(r))
+			for c := 0; c < n; c++ {
+				m[r][c] += ws[j] * xr * math.Pow(x, float64(c))
+			}
+			m[r][n] += ws[j] * xr * ys[j]
+		}
+	}
+	for col := 0; col < n; col++ { // gaussian elimination with partial pivoting
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(m[r][col]) > math.Abs(m[pivot][col]) {
+				pivot = r
+			}
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		if m[col][col] == 0 {
+			continue
+		}
+		for r := col + 1; r < n; r++ {
+			f := m[r][col] / m[col][col]
+			for c := col; c <= n; c++ {
+				m[r][c] -= f * m[col][c]
+			}
+		}
+	}
+	coef := make([]float64, n)
+	for r := n - 1; r >= 0; r-- {
+		if m[r][r] == 0 {
+			continue
+		}
+		sum := m[r][n]
+		for c := r + 1; c < n; c++ {
+			sum -= m[r][c] * coef[c]
+		}
+		coef[r] = sum / m[r][r]
+	}
+	return coef[0]
+}
+
+// smoothedPoint copy of the original under a new external id, its value, band bounds
+// and candle open each smoothed by fit; the bounds are widened to keep the smoothed
+// value and open inside them. Uncertainty, colors, timestamp and metadata are kept
+func smoothedPoint(original ChartDatapoint, fit func(channel pointChannel) float64) *ChartDatapoint {
+	point := original.Copy()
+	value := float32(fit(valueChannel))
+	point.SetValue(value)
+	open := value
+	if cp, ok := point.(*chartDatapoint); ok {
+		cp.externalID = uuid.New().String()
+		if cp.ohlc {
+			open = float32(fit(openChannel))
+			cp.open = open
+		}
+	}
+	if _, _, ok := original.Band(); ok {
+		low := float32(math.Min(fit(lowChannel), math.Min(float64(value), float64(open))))
+		high := float32(math.Max(fit(highChannel), math.Max(float64(value), float64(open))))
+		point.SetBand(low, high)
+	}
+	return &point
+}
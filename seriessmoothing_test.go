@@ -0,0 +1,112 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"time"
+)
+
+var _ = Describe("Whole series smoothing", func() {
+
+	series := func(values ...float32) []*sknlinechart.ChartDatapoint {
+		var points []*sknlinechart.ChartDatapoint
+		for _, v := range values {
+			point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			points = append(points, &point)
+		}
+		return points
+	}
+	values := func(points []*sknlinechart.ChartDatapoint) []float64 {
+		var vals []float64
+		for _, p := range points {
+			vals = append(vals, float64((*p).Value()))
+		}
+		return vals
+	}
+
+	It("should keep polynomial data unchanged with Savitzky-Golay", func() {
+		quadratic := series(0, 1, 4, 9, 16, 25, 36, 49)
+		smoothed, err := sknlinechart.SavitzkyGolay(quadratic, 5, 2)
+		Expect(err).NotTo(HaveOccurred())
+		for idx, v := range values(smoothed) {
+			Expect(v).To(BeNumerically("~", float64(idx*idx), 1e-3))
+		}
+		Expect((*smoothed[3]).Timestamp()).To(Equal((*quadratic[3]).Timestamp()))
+	})
+
+	It("should keep uncertainty and metadata on smoothed points", func() {
+		var points []*sknlinechart.ChartDatapoint
+		for idx := 0; idx < 5; idx++ {
+			point := sknlinechart.NewChartDatapointOHLC(1, 4, 0.5, float32(idx), time.Now().Format(time.RFC1123))
+			point.SetUncertainty(0.25, 0.75)
+			point.SetMetadata("source", "probe")
+			points = append(points, &point)
+		}
+		smoothed, err := sknlinechart.SavitzkyGolay(points, 3, 1)
+		Expect(err).NotTo(HaveOccurred())
+		open, high, low, _, ok := (*smoothed[2]).OHLC()
+		Expect(ok).To(BeTrue())
+		Expect([]float32{open, high, low}).To(Equal([]float32{1, 4, 0.5}))
+		minus, plus, ok := (*smoothed[2]).Uncertainty()
+		Expect(ok).To(BeTrue())
+		Expect([]float32{minus, plus}).To(Equal([]float32{0.25, 0.75}))
+		Expect((*smoothed[2]).Metadata()).To(HaveKeyWithValue("source", "probe"))
+		Expect((*smoothed[2]).ExternalID()).NotTo(Equal((*points[2]).ExternalID()))
+	})
+
+	It("should smooth candle and band bounds along with the value", func() {
+		var candles, bands []*sknlinechart.ChartDatapoint
+		for _, v := range []float32{0, 10, 0, 10, 0} {
+			candle := sknlinechart.NewChartDatapointOHLC(v-1, v+2, v-2, v, time.Now().Format(time.RFC1123))
+			band := sknlinechart.NewChartDatapointBand(v-2, v, v+2, theme.ColorBlue, time.Now().Format(time.RFC1123))
+			candles, bands = append(candles, &candle), append(bands, &band)
+		}
+		smoothed, err := sknlinechart.SavitzkyGolay(candles, 3, 0)
+		Expect(err).NotTo(HaveOccurred())
+		open, high, low, closed, _ := (*smoothed[2]).OHLC()
+		Expect(closed).To(BeNumerically("~", 20.0/3, 1e-4))
+		Expect(open).To(BeNumerically("~", 17.0/3, 1e-4))
+		Expect(high).To(BeNumerically("~", 26.0/3, 1e-4))
+		Expect(low).To(BeNumerically("~", 14.0/3, 1e-4))
+
+		By("keeping smoothed values within their smoothed bounds")
+		for _, series := range [][]*sknlinechart.ChartDatapoint{candles, bands} {
+			smoothed, err = sknlinechart.Loess(series, 0.6, 2)
+			Expect(err).NotTo(HaveOccurred())
+			for _, point := range smoothed {
+				low, high, ok := (*point).Band()
+				Expect(ok).To(BeTrue())
+				Expect((*point).Value()).To(BeNumerically(">=", low))
+				Expect((*point).Value()).To(BeNumerically("<=", high))
+			}
+		}
+	})
+
+	It("should flatten a glitch with Savitzky-Golay", func() {
+		smoothed, err := sknlinechart.SavitzkyGolay(series(10, 10, 10, 40, 10, 10, 10), 5, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(values(smoothed)[3]).To(BeNumerically("<", 40))
+	})
+
+	It("should reject bad Savitzky-Golay and LOESS settings", func() {
+		points := series(1, 2, 3, 4, 5)
+		_, err := sknlinechart.SavitzkyGolay(points, 4, 2)
+		Expect(err).To(HaveOccurred())
+		_, err = sknlinechart.SavitzkyGolay(points, 7, 2)
+		Expect(err).To(HaveOccurred())
+		_, err = sknlinechart.Loess(points, 0, 1)
+		Expect(err).To(HaveOccurred())
+		_, err = sknlinechart.Loess(points, 0.5, 3)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should keep linear data unchanged with LOESS", func() {
+		smoothed, err := sknlinechart.Loess(series(2, 4, 6, 8, 10, 12, 14, 16, 18, 20), 0.5, 1)
+		Expect(err).NotTo(HaveOccurred())
+		for idx, v := range values(smoothed) {
+			Expect(v).To(BeNumerically("~", float64(2*idx+2), 1e-3))
+		}
+	})
+})
@@ -0,0 +1,187 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"fyne.io/fyne/v2/container"
+	"image/color"
+	"math"
+)
+
+// MarkerShape shape drawn at each datapoint of a series
+type MarkerShape int
+
+const (
+	MarkerCircle MarkerShape = iota
+	MarkerSquare
+	MarkerTriangle
+	MarkerNone
+)
+
+// SeriesMode how a series' datapoints are rendered
+type SeriesMode int
+
+const (
+	SeriesLine        SeriesMode = iota // line segments joining the datapoints
+	SeriesArea                          // line with the region down to the baseline filled
+	SeriesStackedArea                   // filled area stacked on top of the stacked series sorted before it
+	SeriesBar                           // vertical bar at each datapoint, grouped beside the other bar series
+	SeriesScatter                       // markers only, without connecting lines
+	SeriesBand                          // shaded region between each datapoint's low and high bounds
+	SeriesCandlestick                   // open/close box with a low/high wick at each datapoint
+)
+
+// SeriesStyle drawing attributes for one series, zero values use the chart defaults
+type SeriesStyle struct {
+	Color          color.Color   // any color; takes precedence over ColorName
+	ColorName      string        // primary color name or hex string; empty uses each datapoint's color
+	StrokeWidth    float32       // line thickness; zero uses the chart's line stroke size
+	DashPattern    []float32     // alternating on/off lengths in pixels; empty draws solid lines
+	MarkerShape    MarkerShape   // circle by default
+	MarkerSize     float32       // marker width and height; zero uses twice the stroke width
+	Opacity        float32       // 0.0 to 1.0; zero is fully opaque
+	Mode           SeriesMode    // line by default
+	Interpolation  Interpolation // straight segments by default
+	FillAlpha      uint8         // area fill alpha; zero uses 0x60
+	FillGradient   bool          // area fill fades out toward the baseline
+	BandCenterLine bool          // band series also draw the line through each datapoint's value
+	UpColor        color.Color   // candlestick closing at or above its open; nil uses green
+	DownColor      color.Color   // candlestick closing below its open; nil uses red
+}
+
+// validate rejects styles that cannot be drawn
+func (s SeriesStyle) validate(series string) error {
+	if s.Opacity < 0 || s.Opacity > 1 {
+		return fmt.Errorf("[%s] series style opacity must be between 0.0 and 1.0, got %.2f", series, s.Opacity)
+	}
+	if s.StrokeWidth < 0 || s.MarkerSize < 0 {
+		return fmt.Errorf("[%s] series style stroke width and marker size cannot be negative", series)
+	}
+	var total float32
+	for _, p := range s.DashPattern {
+		if p < 0 {
+			return fmt.Errorf("[%s] series style dash pattern cannot have negative lengths, got %v", series, s.DashPattern)
+		}
+		total += p
+	}
+	if len(s.DashPattern) > 0 && total == 0 {
+		return fmt.Errorf("[%s] series style dash pattern cannot be all zero", series)
+	}
+	return nil
+}
+
+// resolved returns a copy of the style with chart defaults applied
+func (s SeriesStyle) resolved(defaultStroke float32) SeriesStyle {
+	if s.StrokeWidth <= 0 {
+		s.StrokeWidth = defaultStroke
+	}
+	if s.MarkerSize <= 0 {
+		s.MarkerSize = s.StrokeWidth * 2
+	}
+	if s.Opacity <= 0 || s.Opacity > 1 {
+		s.Opacity = 1
+	}
+	if s.FillAlpha == 0 {
+		s.FillAlpha = 0x60
+	}
+	return s
+}
+
+// newDataPointMarker creates the canvas object for a marker shape
+// triangles are three lines in a container since canvas has no polygon
+func newDataPointMarker(shape MarkerShape, c color.Color) fyne.CanvasObject {
+	switch shape {
+	case MarkerSquare:
+		return canvas.NewRectangle(c)
+	case MarkerTriangle:
+		return container.NewWithoutLayout(canvas.NewLine(c), canvas.NewLine(c), canvas.NewLine(c))
+	default:
+		return canvas.NewCircle(c)
+	}
+}
+
+// markerMatchesShape reports whether the marker object draws the shape, none is drawn by a hidden circle
+func markerMatchesShape(marker fyne.CanvasObject, shape MarkerShape) bool {
+	switch marker.(type) {
+	case *canvas.Rectangle:
+		return shape == MarkerSquare
+	case *fyne.Container:
+		return shape == MarkerTriangle
+	default:
+		return shape == MarkerCircle || shape == MarkerNone
+	}
+}
+
+// placeDataPointMarker centers the marker on the datapoint and colors it
+func placeDataPointMarker(marker fyne.CanvasObject, center fyne.Position, size float32, c color.Color) {
+	half := size / 2
+	switch m := marker.(type) {
+	case *canvas.Circle:
+		m.Position1 = fyne.NewPos(center.X-half, center.Y-half)
+		m.Position2 = fyne.NewPos(center.X+half, center.Y+half)
+		m.FillColor = c
+	case *canvas.Rectangle:
+		m.Move(fyne.NewPos(center.X-half, center.Y-half))
+		m.Resize(fyne.NewSize(size, size))
+		m.FillColor = c
+	case *fyne.Container:
+		m.Move(fyne.NewPos(center.X-half, center.Y-half))
+		m.Resize(fyne.NewSize(size, size))
+		corners := []fyne.Position{
+			fyne.NewPos(half, 0),
+			fyne.NewPos(size, size),
+			fyne.NewPos(0, size),
+		}
+		for idx, o := range m.Objects {
+			side := o.(*canvas.Line)
+			side.Position1 = corners[idx]
+			side.Position2 = corners[(idx+1)%len(corners)]
+			side.StrokeColor = c
+			side.StrokeWidth = 1.5
+		}
+	}
+}
+
+// dashSegments splits the line from a to b into the visible dashes of the pattern
+// phase carries the position within the pattern from one segment to the next
+func dashSegments(a, b fyne.Position, pattern []float32, phase *float32) [][2]fyne.Position {
+	var total float32
+	for _, p := range pattern {
+		total += p
+	}
+	if len(pattern) == 0 || total <= 0 {
+		return [][2]fyne.Position{{a, b}}
+	}
+
+	dx, dy := b.X-a.X, b.Y-a.Y
+	length := float32(math.Hypot(float64(dx), float64(dy)))
+	if length == 0 {
+		return nil
+	}
+
+	var dashes [][2]fyne.Position
+	var travelled float32
+	for travelled < length {
+		pos := float32(math.Mod(float64(*phase), float64(total)))
+		var acc float32
+		idx := 0
+		for acc+pattern[idx] <= pos+0.01 { // float rounding near a dash boundary counts as reaching it
+			acc += pattern[idx]
+			idx = (idx + 1) % len(pattern)
+		}
+		step := acc + pattern[idx] - pos
+		if step > length-travelled {
+			step = length - travelled
+		}
+		if idx%2 == 0 { // even entries are drawn, odd entries are gaps
+			from := fyne.NewPos(a.X+dx*travelled/length, a.Y+dy*travelled/length)
+			to := fyne.NewPos(a.X+dx*(travelled+step)/length, a.Y+dy*(travelled+step)/length)
+			dashes = append(dashes, [2]fyne.Position{from, to})
+		}
+		travelled += step
+		*phase += step
+	}
+	*phase = float32(math.Mod(float64(*phase), float64(total)))
+	return dashes
+}
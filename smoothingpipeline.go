@@ -0,0 +1,104 @@
+package sknlinechart
+
+import "time"
+
+// smoothedSeries companion series showing a raw series' values run through a chain of smoothers
+type smoothedSeries struct {
+	series string
+	source string
+	chain  []GraphPointSmoothing
+}
+
+var _ seriesDeriver = (*smoothedSeries)(nil)
+
+// timedSmoothing smoothers weighting values by when they were sampled, ex: NewTimeExponentialAverage
+type timedSmoothing interface {
+	AddValueAt(value float64, at time.Time) float64
+}
+
+func (s *smoothedSeries) name() string {
+	return s.series
+}
+
+func (s *smoothedSeries) inputs() []string {
+	return []string{s.source}
+}
+
+func (s *smoothedSeries) derive(series string, point ChartDatapoint, _ map[string][]*ChartDatapoint) (ChartDatapoint, bool) {
+	if series != s.source {
+		return nil, false
+	}
+	v := float64(point.Value())
+	at := parseTimestamp(point.Timestamp()) // replayed points are timed by their own timestamps
+	for _, smoother := range s.chain {
+		if timed, ok := smoother.(timedSmoothing); ok {
+			v = timed.AddValueAt(v, at)
+		} else {
+			v = smoother.AddValue(v)
+		}
+	}
+	return NewChartDatapoint(float32(v), "", point.Timestamp()), true
+}
+
+// companionName the last smoother's series name, or the series name with a Smoothed suffix
+func companionName(series string, chain []GraphPointSmoothing) string {
+	if name := chain[len(chain)-1].SeriesName(); name != "" && name != series {
+		return name
+	}
+	return series + " Smoothed"
+}
+
+// SetSeriesSmoothing runs each datapoint applied to the series through the smoothers,
+// in order, adding the result to a companion series named by the last smoother whose
+// legend entry is linked to the series; no smoothers stops smoothing the series.
+// Returns an error, keeping any earlier smoothing, when the companion name is taken
+func (w *LineChartSkn) SetSeriesSmoothing(series string, smoothers ...GraphPointSmoothing) error {
+	var chain []GraphPointSmoothing
+	for _, smoother := range smoothers {
+		if smoother != nil && !smoother.IsNil() {
+			chain = append(chain, smoother)
+		}
+	}
+	w.mapsLock.Lock()
+	defer w.mapsLock.Unlock()
+	var previous *smoothedSeries
+	for idx, d := range w.derivers {
+		if s, ok := d.(*smoothedSeries); ok && s.source == series {
+			previous = s
+			w.derivers = append(w.derivers[:idx], w.derivers[idx+1:]...)
+			delete(w.linkedSeries, s.series)
+			break
+		}
+	}
+	if len(chain) == 0 {
+		return nil
+	}
+	companion := companionName(series, chain)
+	if err := w.addDeriver(&smoothedSeries{series: companion, source: series, chain: chain}); err != nil {
+		if previous != nil {
+			w.derivers = append(w.derivers, previous)
+			w.linkedSeries[previous.series] = series
+		}
+		return err
+	}
+	w.linkedSeries[companion] = series
+	return nil
+}
+
+// companions smoothed series linked to the series, caller holds the maps lock
+func (w *LineChartSkn) companions(series string) []string {
+	var names []string
+	for companion, source := range w.linkedSeries {
+		if source == series {
+			names = append(names, companion)
+		}
+	}
+	return names
+}
+
+// isHighlighted whether the series, or the series it is linked with, is highlighted
+// by hovering its legend entry; caller holds the maps lock
+func (w *LineChartSkn) isHighlighted(series string) bool {
+	h := w.highlightedSeries
+	return h == "" || h == series || w.linkedSeries[series] == h || w.linkedSeries[h] == series
+}
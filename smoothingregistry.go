@@ -0,0 +1,201 @@
+package sknlinechart
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SmoothingParams named numeric parameters of a smoother, ex: window, alpha
+type SmoothingParams map[string]float64
+
+// SmoothingFactory constructs a smoother for the series from its parameters
+type SmoothingFactory func(seriesName string, params SmoothingParams) (GraphPointSmoothing, error)
+
+var smoothingRegistry = struct {
+	sync.RWMutex
+	factories map[string]SmoothingFactory
+}{factories: map[string]SmoothingFactory{
+	"average": func(seriesName string, params SmoothingParams) (GraphPointSmoothing, error) {
+		if err := params.only("average", "window"); err != nil {
+			return nil, err
+		}
+		window, err := params.window("average")
+		if err != nil {
+			return nil, err
+		}
+		return NewGraphAverageWindow(seriesName, window), nil
+	},
+	"ema": func(seriesName string, params SmoothingParams) (GraphPointSmoothing, error) {
+		if err := params.only("ema", "alpha", "halfLife", "halfLifeSeconds"); err != nil {
+			return nil, err
+		}
+		var ema *ExponentialAverage
+		var err error
+		if v, ok := params["halfLifeSeconds"]; ok {
+			ema, err = NewTimeExponentialAverage(seriesName, time.Duration(v*float64(time.Second)))
+		} else if v, ok := params["halfLife"]; ok {
+			ema, err = NewExponentialAverageHalfLife(seriesName, v)
+		} else {
+			var alpha float64
+			if alpha, err = params.require("ema", "alpha"); err != nil {
+				return nil, err
+			}
+			ema, err = NewExponentialAverage(seriesName, alpha)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ema, nil
+	},
+	"median": func(seriesName string, params SmoothingParams) (GraphPointSmoothing, error) {
+		if err := params.only("median", "window"); err != nil {
+			return nil, err
+		}
+		window, err := params.window("median")
+		if err != nil {
+			return nil, err
+		}
+		return NewRollingMedian(seriesName, window), nil
+	},
+	"hampel": func(seriesName string, params SmoothingParams) (GraphPointSmoothing, error) {
+		if err := params.only("hampel", "window", "threshold"); err != nil {
+			return nil, err
+		}
+		window, err := params.window("hampel")
+		if err != nil {
+			return nil, err
+		}
+		threshold, ok := params["threshold"]
+		if !ok {
+			threshold = 3.0
+		}
+		hampel, err := NewHampelFilter(seriesName, window, threshold)
+		if err != nil {
+			return nil, err
+		}
+		return hampel, nil
+	},
+	"kalman": func(seriesName string, params SmoothingParams) (GraphPointSmoothing, error) {
+		if err := params.only("kalman", "processNoise", "measurementNoise"); err != nil {
+			return nil, err
+		}
+		processNoise, err := params.require("kalman", "processNoise")
+		if err != nil {
+			return nil, err
+		}
+		measurementNoise, err := params.require("kalman", "measurementNoise")
+		if err != nil {
+			return nil, err
+		}
+		kf, err := NewKalmanFilter(seriesName, processNoise, measurementNoise)
+		if err != nil {
+			return nil, err
+		}
+		return kf, nil
+	},
+}}
+
+// require the named parameter's value, or an error naming the smoother
+func (p SmoothingParams) require(kind, name string) (float64, error) {
+	v, ok := p[name]
+	if !ok {
+		return 0, fmt.Errorf("[%s] smoothing missing parameter %s", kind, name)
+	}
+	return v, nil
+}
+
+// window the window parameter, a whole number of values of at least one
+func (p SmoothingParams) window(kind string) (int, error) {
+	v, err := p.require(kind, "window")
+	if err != nil {
+		return 0, err
+	}
+	if v < 1 || v > math.MaxInt32 || v != math.Trunc(v) {
+		return 0, fmt.Errorf("[%s] smoothing window must be a whole number of at least 1, got %v", kind, v)
+	}
+	return int(v), nil
+}
+
+// only errors on any parameter not named, catching misspelled config keys
+func (p SmoothingParams) only(kind string, names ...string) error {
+	for key := range p {
+		known := false
+		for _, name := range names {
+			known = known || key == name
+		}
+		if !known {
+			return fmt.Errorf("[%s] smoothing unknown parameter %s", kind, key)
+		}
+	}
+	return nil
+}
+
+// RegisterSmoothing makes a smoother constructible by kind, replacing any
+// registered under the same kind
+func RegisterSmoothing(kind string, factory SmoothingFactory) error {
+	if kind == "" || factory == nil {
+		return fmt.Errorf("[%s] smoothing kind and factory are required", kind)
+	}
+	smoothingRegistry.Lock()
+	defer smoothingRegistry.Unlock()
+	smoothingRegistry.factories[kind] = factory
+	return nil
+}
+
+// UnregisterSmoothing removes the kind, built in kinds included, so NewSmoothing no longer constructs it
+func UnregisterSmoothing(kind string) {
+	smoothingRegistry.Lock()
+	defer smoothingRegistry.Unlock()
+	delete(smoothingRegistry.factories, kind)
+}
+
+// SmoothingKinds sorted kinds of the registered smoothers
+func SmoothingKinds() []string {
+	smoothingRegistry.RLock()
+	defer smoothingRegistry.RUnlock()
+	kinds := make([]string, 0, len(smoothingRegistry.factories))
+	for kind := range smoothingRegistry.factories {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	return kinds
+}
+
+// NewSmoothing constructs the registered smoother of the kind;
+// built in kinds are average, ema, median, hampel and kalman
+func NewSmoothing(kind, seriesName string, params SmoothingParams) (GraphPointSmoothing, error) {
+	smoothingRegistry.RLock()
+	factory, ok := smoothingRegistry.factories[kind]
+	smoothingRegistry.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("[%s] unknown smoothing kind, registered: %s", kind, strings.Join(SmoothingKinds(), ", "))
+	}
+	return factory(seriesName, params)
+}
+
+// ParseSmoothing constructs a smoother from a spec of the form
+// kind:name=value,name=value, ex: "hampel:window=7,threshold=3"
+func ParseSmoothing(spec, seriesName string) (GraphPointSmoothing, error) {
+	kind, list, _ := strings.Cut(strings.TrimSpace(spec), ":")
+	params := SmoothingParams{}
+	for _, pair := range strings.Split(list, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("[%s] smoothing parameter %q is not name=value", spec, pair)
+		}
+		v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("[%s] smoothing parameter %q: %w", spec, pair, err)
+		}
+		params[strings.TrimSpace(name)] = v
+	}
+	return NewSmoothing(strings.TrimSpace(kind), seriesName, params)
+}
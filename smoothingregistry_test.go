@@ -0,0 +1,95 @@
+package sknlinechart_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+	"sync"
+)
+
+var _ = Describe("Smoothing registry", func() {
+
+	It("should construct the built in smoothers by kind and parameters", func() {
+		Expect(sknlinechart.SmoothingKinds()).To(ContainElements("average", "ema", "median", "hampel", "kalman"))
+
+		avg, err := sknlinechart.NewSmoothing("average", "Smooth", sknlinechart.SmoothingParams{"window": 3})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(avg.Window()).To(Equal(3))
+		Expect(avg.SeriesName()).To(Equal("Smooth"))
+
+		hampel, err := sknlinechart.ParseSmoothing("hampel: window=7, threshold=2.5", "Clean")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(hampel.Window()).To(Equal(7))
+
+		_, err = sknlinechart.NewSmoothing("bogus", "Smooth", nil)
+		Expect(err).To(HaveOccurred())
+		_, err = sknlinechart.ParseSmoothing("median:windw=5", "Smooth")
+		Expect(err).To(HaveOccurred())
+		_, err = sknlinechart.ParseSmoothing("kalman:processNoise=0.1", "Smooth")
+		Expect(err).To(HaveOccurred())
+
+		By("rejecting windows that are not a whole number of values")
+		for _, spec := range []string{"average:window=0", "median:window=-3", "hampel:window=2.5", "average:window=NaN"} {
+			_, err = sknlinechart.ParseSmoothing(spec, "Smooth")
+			Expect(err).To(MatchError(ContainSubstring("window must be a whole number")), spec)
+		}
+	})
+
+	It("should construct registered smoothers", func() {
+		Expect(sknlinechart.RegisterSmoothing("last", func(seriesName string, _ sknlinechart.SmoothingParams) (sknlinechart.GraphPointSmoothing, error) {
+			return sknlinechart.NewRollingMedian(seriesName, 1), nil
+		})).To(Succeed())
+		DeferCleanup(sknlinechart.UnregisterSmoothing, "last")
+		last, err := sknlinechart.ParseSmoothing("last", "Last")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(last.AddValue(42)).To(Equal(42.0))
+
+		By("no longer constructing an unregistered kind")
+		sknlinechart.UnregisterSmoothing("last")
+		_, err = sknlinechart.ParseSmoothing("last", "Last")
+		Expect(err).To(HaveOccurred())
+		Expect(sknlinechart.SmoothingKinds()).NotTo(ContainElement("last"))
+	})
+
+	It("should average without a seed value and start over on reset", func() {
+		avg := sknlinechart.NewGraphAverageWindow("Smooth", 2)
+		Expect(avg.Current()).To(BeZero())
+		Expect(avg.AddValue(10)).To(Equal(10.0))
+		Expect(avg.AddValue(20)).To(Equal(15.0))
+		Expect(avg.AddValue(40)).To(Equal(30.0))
+		Expect(avg.Current()).To(Equal(30.0))
+		Expect(avg.String()).NotTo(ContainSubstring("["))
+
+		avg.Reset()
+		Expect(avg.Current()).To(BeZero())
+		Expect(avg.AddValue(7)).To(Equal(7.0))
+	})
+
+	It("should reset every built in smoother", func() {
+		for _, spec := range []string{"average:window=4", "ema:alpha=0.5", "median:window=3", "hampel:window=5", "kalman:processNoise=0.1,measurementNoise=1"} {
+			smoother, err := sknlinechart.ParseSmoothing(spec, "Smooth")
+			Expect(err).NotTo(HaveOccurred())
+			smoother.AddValue(5)
+			smoother.AddValue(9)
+			smoother.Reset()
+			Expect(smoother.Current()).To(BeZero(), spec)
+			Expect(smoother.AddValue(3)).To(Equal(3.0), spec)
+		}
+	})
+
+	It("should accept values from several goroutines", func() {
+		avg := sknlinechart.NewGraphAverageWindow("Smooth", 8)
+		var wg sync.WaitGroup
+		for g := 0; g < 4; g++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					avg.AddValue(6)
+				}
+			}()
+		}
+		wg.Wait()
+		Expect(avg.Current()).To(Equal(6.0))
+	})
+})
@@ -0,0 +1,176 @@
+package sknlinechart
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/canvas"
+	"math"
+	"strconv"
+)
+
+// SeriesMatch how datapoints of different series are paired, for XY plots and expression series
+type SeriesMatch int
+
+const (
+	MatchByIndex     SeriesMatch = iota // n-th point of one series with the n-th point of the other
+	MatchByTimestamp                    // points sharing the same timestamp
+)
+
+// XYPlot plots the values of YSeries against the values of XSeries
+// in place of the time series view, optionally with a least squares fit
+type XYPlot struct {
+	XSeries    string
+	YSeries    string
+	Match      SeriesMatch
+	Regression bool
+}
+
+// xyPair y series datapoint paired with its x value
+type xyPair struct {
+	x     float32
+	point *ChartDatapoint
+}
+
+// pairs datapoints of the y series with the matching x series values
+func (p XYPlot) pairs(dataPoints map[string][]*ChartDatapoint) []xyPair {
+	xs := dataPoints[p.XSeries]
+	ys := dataPoints[p.YSeries]
+	var pairs []xyPair
+	if p.Match == MatchByTimestamp {
+		byTime := map[string]float32{}
+		for _, point := range xs {
+			byTime[(*point).Timestamp()] = (*point).Value()
+		}
+		for _, point := range ys {
+			if x, ok := byTime[(*point).Timestamp()]; ok {
+				pairs = append(pairs, xyPair{x: x, point: point})
+			}
+		}
+		return pairs
+	}
+	for idx, point := range ys {
+		if idx >= len(xs) {
+			break
+		}
+		pairs = append(pairs, xyPair{x: (*xs[idx]).Value(), point: point})
+	}
+	return pairs
+}
+
+// linearRegression least squares slope and intercept, false when x does not vary
+func linearRegression(pairs []xyPair) (float32, float32, bool) {
+	n := float64(len(pairs))
+	if n < 2 {
+		return 0, 0, false
+	}
+	var sx, sy, sxx, sxy float64
+	for _, p := range pairs {
+		x, y := float64(p.x), float64((*p.point).Value())
+		sx += x
+		sy += y
+		sxx += x * x
+		sxy += x * y
+	}
+	denom := n*sxx - sx*sx
+	if denom == 0 {
+		return 0, 0, false
+	}
+	slope := (n*sxy - sx*sy) / denom
+	return float32(slope), float32((sy - slope*sx) / n), true
+}
+
+// niceStep smallest 1, 2 or 5 times a power of ten, at least one, covering max over the steps
+func niceStep(max float32, steps int) float32 {
+	raw := float64(max) / float64(steps)
+	if raw <= 1 {
+		return 1
+	}
+	magnitude := math.Pow(10, math.Floor(math.Log10(raw)))
+	for _, m := range []float64{1, 2, 5, 10} {
+		if m*magnitude >= raw {
+			return float32(m * magnitude)
+		}
+	}
+	return float32(10 * magnitude)
+}
+
+// layoutXY places the XY plot markers and regression line, relabeling the x scale
+// with the x series values; without a plot the x scale shows datapoint indexes
+func (r *lineChartRenderer) layoutXY() {
+	plot := r.widget.xyPlot
+	if plot == nil {
+		for _, marker := range r.xyMarkers {
+			marker.Hide()
+		}
+		r.xyRegression.Hide()
+		r.relabelXScale(10)
+		return
+	}
+	pairs := plot.pairs(r.widget.dataPoints)
+	var maxX float32
+	for _, p := range pairs {
+		if p.x > maxX {
+			maxX = p.x
+		}
+	}
+	step := niceStep(maxX, len(r.xLabels)-1)
+	r.relabelXScale(step)
+
+	xp := r.xInc + r.xOffset
+	yp := r.yInc * 14.0
+	yScale := (r.yInc * 10) / (10.0 * float32(r.widget.chartScaleMultiplier))
+	xScale := r.xInc / step
+	hidden := r.widget.hiddenSeries[plot.YSeries]
+	style := r.widget.seriesStyle(plot.YSeries)
+	half := style.MarkerSize / 2
+	if half < 2 {
+		half = 2
+	}
+	for _, point := range r.widget.dataPoints[plot.YSeries] { // unpaired points are not drawn, nor hovered
+		(*point).SetMarkerPosition(&fyne.Position{}, &fyne.Position{})
+	}
+	for len(r.xyMarkers) < len(pairs) {
+		r.xyMarkers = append(r.xyMarkers, canvas.NewCircle(r.widget.seriesColor(plot.YSeries, *pairs[0].point)))
+	}
+	for idx, marker := range r.xyMarkers {
+		if idx >= len(pairs) || hidden {
+			marker.Hide()
+			continue
+		}
+		p := pairs[idx]
+		center := fyne.NewPos(xp+p.x*xScale, yp-r.clampValue((*p.point).Value())*yScale)
+		marker.Position1 = fyne.NewPos(center.X-half, center.Y-half)
+		marker.Position2 = fyne.NewPos(center.X+half, center.Y+half)
+		marker.FillColor = r.widget.seriesColor(plot.YSeries, *p.point)
+		zt, zb := marker.Position1, marker.Position2 // markers are reused, points keep their own copy
+		(*p.point).SetMarkerPosition(&zt, &zb)
+		marker.Show()
+	}
+
+	slope, intercept, ok := linearRegression(pairs)
+	if !plot.Regression || !ok || hidden {
+		r.xyRegression.Hide()
+		return
+	}
+	var minX float32 = maxX
+	for _, p := range pairs {
+		if p.x < minX {
+			minX = p.x
+		}
+	}
+	r.xyRegression.Position1 = fyne.NewPos(xp+minX*xScale, yp-r.clampValue(slope*minX+intercept)*yScale)
+	r.xyRegression.Position2 = fyne.NewPos(xp+maxX*xScale, yp-r.clampValue(slope*maxX+intercept)*yScale)
+	r.xyRegression.StrokeColor = r.widget.seriesColor(plot.YSeries, *pairs[0].point)
+	r.xyRegression.StrokeWidth = style.StrokeWidth
+	r.xyRegression.Show()
+}
+
+// relabelXScale sets the x scale labels to multiples of step
+func (r *lineChartRenderer) relabelXScale(step float32) {
+	for idx, label := range r.xLabels {
+		text := strconv.FormatFloat(float64(float32(idx)*step), 'f', -1, 32)
+		if label.Text != text {
+			label.Text = text
+			label.Refresh()
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package sknlinechart_test
+
+import (
+	"fyne.io/fyne/v2"
+	"fyne.io/fyne/v2/theme"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/skoona/sknlinechart"
+)
+
+var _ = Describe("XY plots", func() {
+	series := func(values []float32, timestamps ...string) []*sknlinechart.ChartDatapoint {
+		var points []*sknlinechart.ChartDatapoint
+		for idx, v := range values {
+			point := sknlinechart.NewChartDatapoint(v, theme.ColorBlue, timestamps[idx])
+			points = append(points, &point)
+		}
+		return points
+	}
+
+	It("should pair the series by index or by timestamp", func() {
+		dataPoints := map[string][]*sknlinechart.ChartDatapoint{
+			"X": series([]float32{1, 2, 3}, "t1", "t2", "t3"),
+			"Y": series([]float32{30, 10, 40}, "t3", "t1", "t4"),
+		}
+		xs, ys := sknlinechart.XYPairs(sknlinechart.XYPlot{XSeries: "X", YSeries: "Y", Match: sknlinechart.MatchByIndex}, dataPoints)
+		Expect(xs).To(Equal([]float32{1, 2, 3}))
+		Expect(ys).To(Equal([]float32{30, 10, 40}))
+
+		xs, ys = sknlinechart.XYPairs(sknlinechart.XYPlot{XSeries: "X", YSeries: "Y", Match: sknlinechart.MatchByTimestamp}, dataPoints)
+		Expect(xs).To(Equal([]float32{3, 1}))
+		Expect(ys).To(Equal([]float32{30, 10}))
+
+		By("stopping at the shorter series when pairing by index")
+		dataPoints["Y"] = dataPoints["Y"][:2]
+		xs, _ = sknlinechart.XYPairs(sknlinechart.XYPlot{XSeries: "X", YSeries: "Y"}, dataPoints)
+		Expect(xs).To(HaveLen(2))
+	})
+
+	It("should fit a least squares line", func() {
+		slope, intercept, ok := sknlinechart.LinearRegression([]float32{1, 2, 3, 4}, []float32{3, 5, 7, 9})
+		Expect(ok).To(BeTrue())
+		Expect(slope).To(BeNumerically("~", 2, 0.0001))
+		Expect(intercept).To(BeNumerically("~", 1, 0.0001))
+
+		slope, _, ok = sknlinechart.LinearRegression([]float32{1, 2, 3}, []float32{2, 1, 3})
+		Expect(ok).To(BeTrue())
+		Expect(slope).To(BeNumerically("~", 0.5, 0.0001))
+
+		_, _, ok = sknlinechart.LinearRegression([]float32{1}, []float32{2})
+		Expect(ok).To(BeFalse())
+		_, _, ok = sknlinechart.LinearRegression([]float32{2, 2}, []float32{1, 5})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should keep hover targets of points that stop pairing off the reused markers", func() {
+		dataPoints := map[string][]*sknlinechart.ChartDatapoint{
+			"X": series([]float32{10, 20}, "t1", "t2"),
+			"Y": series([]float32{30, 60}, "t1", "t2"),
+		}
+		lc, _ := sknlinechart.NewLineChart("XY", "Plot", 10, &dataPoints)
+		lc.SetXYPlot(&sknlinechart.XYPlot{XSeries: "X", YSeries: "Y", Match: sknlinechart.MatchByTimestamp})
+		lc.Resize(fyne.NewSize(800, 400))
+		lc.Refresh()
+		first, second := *dataPoints["Y"][0], *dataPoints["Y"][1]
+		top, _ := first.MarkerPosition()
+		Expect(*top).NotTo(Equal(fyne.Position{}))
+
+		Expect(lc.ApplyDataSeries("X", series([]float32{20}, "t2"))).To(Succeed())
+		lc.Refresh()
+		top, _ = first.MarkerPosition()
+		Expect(*top).To(Equal(fyne.Position{}))
+		secondTop, _ := second.MarkerPosition()
+		Expect(*secondTop).NotTo(Equal(fyne.Position{}))
+	})
+	It("should select only the plotted y series' points by dragging across an XY plot", func() {
+		lc := renderedUI()
+		xs := series([]float32{10, 20, 30}, "t1", "t2", "t3")
+		for idx, y := range series([]float32{30, 60, 90}, "t1", "t2", "t3") {
+			lc.ApplyDataPoint("X", xs[idx])
+			lc.ApplyDataPoint("Y", y)
+		}
+		top, _ := (*xs[0]).MarkerPosition() // laid out as a time series, stale once plotted as x
+		Expect(*top).NotTo(Equal(fyne.Position{}))
+		lc.SetXYPlot(&sknlinechart.XYPlot{XSeries: "X", YSeries: "Y"})
+
+		var selected map[string][]sknlinechart.ChartDatapoint
+		lc.SetOnRangeSelectedCallback(func(_, _ int, pointsBySeries map[string][]sknlinechart.ChartDatapoint) {
+			selected = pointsBySeries
+		})
+		lc.SetRangeSelection(true)
+		lc.(fyne.Draggable).Dragged(&fyne.DragEvent{
+			PointEvent: fyne.PointEvent{Position: fyne.NewPos(800, 200)},
+			Dragged:    fyne.NewDelta(800, 0),
+		})
+		lc.(fyne.Draggable).DragEnd()
+		Expect(selected).To(HaveLen(1))
+		Expect(selected["Y"]).To(HaveLen(3))
+	})
+})